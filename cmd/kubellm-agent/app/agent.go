@@ -0,0 +1,211 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+
+	"github.com/kubellm-io/kubellm/cmd/kubellm-agent/app/options"
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/impersonation"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/status"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	externalinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+	"github.com/kubellm-io/kubellm/pkg/util/tunnel"
+)
+
+// NewAgentCommand 创建 kubellm-agent 的启动命令
+func NewAgentCommand(ctx context.Context) *cobra.Command {
+	opts := options.NewOptions()
+	cmd := &cobra.Command{
+		Use: "kubellm-agent",
+		Long: `The kubellm-agent runs in a Pull mode member cluster. It registers the cluster to the kubellm control plane,
+reports the cluster status and keeps a reverse tunnel, so the control plane needs no inbound connectivity to the cluster.
+Requests through the tunnel are forwarded with an impersonator that may only impersonate kubellm users and their groups.`,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+			if errs := opts.Validate(); len(errs) != 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			return Run(c.Context(), opts)
+		},
+	}
+	cmd.SetContext(ctx)
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// Run 根据配置启动 kubellm-agent，直到 ctx 结束
+func Run(ctx context.Context, opts *options.Options) error {
+	memberConfig, err := clientcmd.BuildConfigFromFlags("", opts.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to build member cluster kubeconfig: %w", err)
+	}
+	memberConfig.QPS = opts.KubeAPIQPS
+	memberConfig.Burst = opts.KubeAPIBurst
+
+	controlPlaneConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: opts.ControlPlaneKubeconfig},
+		&clientcmd.ConfigOverrides{CurrentContext: opts.ControlPlaneContext},
+	).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("failed to build control plane kubeconfig: %w", err)
+	}
+	controlPlaneConfig.QPS = opts.KubeAPIQPS
+	controlPlaneConfig.Burst = opts.KubeAPIBurst
+
+	memberClient, err := kubernetes.NewForConfig(rest.AddUserAgent(memberConfig, "kubellm-agent"))
+	if err != nil {
+		return err
+	}
+	kubellmClient, err := versioned.NewForConfig(rest.AddUserAgent(controlPlaneConfig, "kubellm-agent"))
+	if err != nil {
+		return err
+	}
+
+	if err := registerCluster(ctx, opts, kubellmClient, memberClient); err != nil {
+		return err
+	}
+
+	if err := startClusterStatusController(ctx, opts, kubellmClient, memberConfig); err != nil {
+		return err
+	}
+
+	if err := startImpersonationController(ctx, kubellmClient, memberClient); err != nil {
+		return err
+	}
+
+	klog.InfoS("Started kubellm-agent", "cluster", opts.ClusterName)
+	// 隧道断开后按固定间隔重新建立，直到 ctx 结束
+	wait.JitterUntilWithContext(ctx, func(ctx context.Context) {
+		// 每次建立隧道时重新读取 impersonator 的 token，token 被轮换后重连即可生效
+		impersonatorConfig, err := impersonation.ImpersonatorConfig(ctx, memberClient, memberConfig)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get impersonator credentials", "cluster", opts.ClusterName)
+			return
+		}
+		proxyHandler, err := tunnel.NewProxyHandler(impersonatorConfig)
+		if err != nil {
+			klog.ErrorS(err, "Failed to create tunnel proxy handler", "cluster", opts.ClusterName)
+			return
+		}
+
+		conn, err := tunnel.Dial(ctx, controlPlaneConfig, opts.ClusterName)
+		if err != nil {
+			klog.ErrorS(err, "Failed to establish tunnel to the control plane", "cluster", opts.ClusterName)
+			return
+		}
+		klog.InfoS("Tunnel established", "cluster", opts.ClusterName)
+		tunnel.Serve(ctx, conn, proxyHandler)
+		klog.InfoS("Tunnel closed", "cluster", opts.ClusterName)
+	}, opts.TunnelRetryPeriod, 0.2, true)
+	return nil
+}
+
+// registerCluster 以 Pull 模式注册 Cluster。
+// Cluster 已存在时要求它是 Pull 模式，并且 ID 与当前成员集群一致，避免误接管其他集群。
+func registerCluster(ctx context.Context, opts *options.Options, kubellmClient versioned.Interface, memberClient kubernetes.Interface) error {
+	id, err := membercluster.GetClusterID(ctx, memberClient)
+	if err != nil {
+		return err
+	}
+
+	clusters := kubellmClient.ClusterV1alpha1().Clusters()
+	cluster, err := clusters.Get(ctx, opts.ClusterName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cluster = &clusterv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: opts.ClusterName},
+			Spec: clusterv1alpha1.ClusterSpec{
+				ID:          id,
+				SyncMode:    clusterv1alpha1.Pull,
+				APIEndpoint: opts.ClusterAPIEndpoint,
+				Provider:    opts.ClusterProvider,
+				Region:      opts.ClusterRegion,
+			},
+		}
+		if _, err := clusters.Create(ctx, cluster, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to register cluster %s: %w", opts.ClusterName, err)
+		}
+		klog.InfoS("Registered cluster", "cluster", opts.ClusterName, "id", id)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get cluster %s: %w", opts.ClusterName, err)
+	}
+
+	if cluster.Spec.SyncMode != clusterv1alpha1.Pull {
+		return fmt.Errorf("cluster %s is already registered in %s mode", opts.ClusterName, cluster.Spec.SyncMode)
+	}
+	if len(cluster.Spec.ID) > 0 && cluster.Spec.ID != id {
+		return fmt.Errorf("cluster %s is already registered with id %s, but the id of this cluster is %s", opts.ClusterName, cluster.Spec.ID, id)
+	}
+	if len(cluster.Spec.ID) == 0 {
+		// 成员集群的身份只能修改 status 子资源，尚未设置的 spec.id 通过它上报
+		cluster = cluster.DeepCopy()
+		cluster.Spec.ID = id
+		if _, err := clusters.UpdateStatus(ctx, cluster, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to set id of cluster %s: %w", opts.ClusterName, err)
+		}
+	}
+	klog.InfoS("Cluster is already registered", "cluster", opts.ClusterName, "id", id)
+	return nil
+}
+
+// startClusterStatusController 启动只处理当前集群的状态控制器，使用成员集群内的凭证收集状态
+func startClusterStatusController(ctx context.Context, opts *options.Options, kubellmClient versioned.Interface, memberConfig *rest.Config) error {
+	// 收集状态的请求不应超过上报间隔，避免成员集群响应缓慢时阻塞后续的收集
+	statusConfig := rest.CopyConfig(memberConfig)
+	statusConfig.Timeout = opts.ClusterStatusUpdateFrequency
+	memberClient, err := kubernetes.NewForConfig(rest.AddUserAgent(statusConfig, "kubellm-agent"))
+	if err != nil {
+		return err
+	}
+
+	informerFactory := externalinformers.NewSharedInformerFactoryWithOptions(kubellmClient, 0,
+		externalinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", opts.ClusterName).String()
+		}))
+
+	c, err := status.NewController(
+		kubellmClient,
+		informerFactory.Cluster().V1alpha1().Clusters(),
+		func(*clusterv1alpha1.Cluster) (kubernetes.Interface, error) { return memberClient, nil },
+		opts.ClusterStatusUpdateFrequency,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	informerFactory.Start(ctx.Done())
+	go c.Run(ctx, 1)
+	return nil
+}
+
+// startImpersonationController 启动在成员集群中维护 impersonator 的控制器。
+// 隧道中的请求只以 impersonator 的身份转发，它只能模拟控制面中存在的 User 及其所属的组。
+func startImpersonationController(ctx context.Context, kubellmClient versioned.Interface, memberClient kubernetes.Interface) error {
+	informerFactory := externalinformers.NewSharedInformerFactory(kubellmClient, 0)
+	c, err := impersonation.NewAgentController(memberClient, informerFactory.Iam().V1alpha1().Users())
+	if err != nil {
+		return err
+	}
+
+	informerFactory.Start(ctx.Done())
+	go c.Run(ctx)
+	return nil
+}
@@ -0,0 +1,93 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+)
+
+// Options 是 kubellm-agent 的统一配置源，所有命令行参数都在这里定义
+type Options struct {
+	// ControlPlaneKubeconfig 是访问 kubellm 控制面的 kubeconfig 路径
+	ControlPlaneKubeconfig string
+	// ControlPlaneContext 是 ControlPlaneKubeconfig 中使用的 context，为空时使用 current-context
+	ControlPlaneContext string
+	// Kubeconfig 是访问成员集群的 kubeconfig 路径，为空时使用 in-cluster 配置
+	Kubeconfig string
+	// KubeAPIQPS 与 KubeAPIBurst 限制访问成员集群与控制面的速率
+	KubeAPIQPS   float32
+	KubeAPIBurst int
+
+	// ClusterName 是注册到控制面的 Cluster 名称
+	ClusterName string
+	// ClusterAPIEndpoint 是成员集群的 API 地址，仅用于展示，Pull 模式下控制面不会直接访问该地址
+	ClusterAPIEndpoint string
+	// ClusterProvider 与 ClusterRegion 会在注册时写入 Cluster
+	ClusterProvider string
+	ClusterRegion   string
+
+	// ClusterStatusUpdateFrequency 是 agent 上报集群状态的间隔
+	ClusterStatusUpdateFrequency time.Duration
+	// TunnelRetryPeriod 是隧道断开后重新建立的间隔
+	TunnelRetryPeriod time.Duration
+}
+
+// NewOptions 创建带有默认值的 Options
+func NewOptions() *Options {
+	return &Options{
+		KubeAPIQPS:                   40,
+		KubeAPIBurst:                 60,
+		ClusterStatusUpdateFrequency: 10 * time.Second,
+		TunnelRetryPeriod:            5 * time.Second,
+	}
+}
+
+// AddFlags 将所有配置项注册为命令行参数
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ControlPlaneKubeconfig, "control-plane-kubeconfig", o.ControlPlaneKubeconfig,
+		"Path to the kubeconfig file of the kubellm control plane.")
+	fs.StringVar(&o.ControlPlaneContext, "control-plane-context", o.ControlPlaneContext,
+		"Name of the kubeconfig context to use for the kubellm control plane. Defaults to the current context.")
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig,
+		"Path to the kubeconfig file of the member cluster. Uses in-cluster config if empty.")
+	fs.Float32Var(&o.KubeAPIQPS, "kube-api-qps", o.KubeAPIQPS, "QPS to use while talking with the Kubernetes API servers.")
+	fs.IntVar(&o.KubeAPIBurst, "kube-api-burst", o.KubeAPIBurst, "Burst to use while talking with the Kubernetes API servers.")
+
+	fs.StringVar(&o.ClusterName, "cluster-name", o.ClusterName, "Name of the member cluster registered to the kubellm control plane.")
+	fs.StringVar(&o.ClusterAPIEndpoint, "cluster-api-endpoint", o.ClusterAPIEndpoint,
+		"API endpoint of the member cluster, for display only. The control plane reaches Pull mode clusters through the agent tunnel.")
+	fs.StringVar(&o.ClusterProvider, "cluster-provider", o.ClusterProvider, "Cloud provider name of the member cluster.")
+	fs.StringVar(&o.ClusterRegion, "cluster-region", o.ClusterRegion, "Region in which the member cluster is located.")
+
+	fs.DurationVar(&o.ClusterStatusUpdateFrequency, "cluster-status-update-frequency", o.ClusterStatusUpdateFrequency,
+		"Specifies how often the agent reports the status of the member cluster.")
+	fs.DurationVar(&o.TunnelRetryPeriod, "tunnel-retry-period", o.TunnelRetryPeriod,
+		"The duration the agent waits before re-establishing a broken tunnel to the control plane.")
+}
+
+// Complete 补全未显式设置的配置项
+func (o *Options) Complete() error {
+	return nil
+}
+
+// Validate 校验配置项
+func (o *Options) Validate() []error {
+	var errs []error
+	if len(o.ControlPlaneKubeconfig) == 0 {
+		errs = append(errs, fmt.Errorf("--control-plane-kubeconfig must be set"))
+	}
+	if len(o.ClusterName) == 0 {
+		errs = append(errs, fmt.Errorf("--cluster-name must be set"))
+	} else if msgs := path.ValidatePathSegmentName(o.ClusterName, false); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid --cluster-name %q: %v", o.ClusterName, msgs))
+	}
+	if o.ClusterStatusUpdateFrequency <= 0 {
+		errs = append(errs, fmt.Errorf("--cluster-status-update-frequency must be greater than 0"))
+	}
+	if o.TunnelRetryPeriod <= 0 {
+		errs = append(errs, fmt.Errorf("--tunnel-retry-period must be greater than 0"))
+	}
+	return errs
+}
@@ -0,0 +1,146 @@
+package options
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	openapinamer "k8s.io/apiserver/pkg/endpoints/openapi"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	genericfilters "k8s.io/apiserver/pkg/server/filters"
+	genericoptions "k8s.io/apiserver/pkg/server/options"
+	"k8s.io/client-go/kubernetes"
+	basecompatibility "k8s.io/component-base/compatibility"
+	baseversion "k8s.io/component-base/version"
+	netutils "k8s.io/utils/net"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/apiserver"
+	"github.com/kubellm-io/kubellm/pkg/auth/oauth"
+	"github.com/kubellm-io/kubellm/pkg/auth/password"
+	"github.com/kubellm-io/kubellm/pkg/auth/signup"
+	generatedopenapi "github.com/kubellm-io/kubellm/pkg/generated/openapi"
+)
+
+const defaultEtcdPathPrefix = "/registry/kubellm.io"
+
+// Options 是 kubellm-apiserver 的统一配置源，所有命令行参数都在这里定义
+type Options struct {
+	RecommendedOptions *genericoptions.RecommendedOptions
+	Token              *TokenOptions
+	Signup             *SignupOptions
+	Tunnel             *TunnelOptions
+
+	// AlternateDNS 是自签名证书中额外的 DNS 名称
+	AlternateDNS []string
+	// PasswordPolicyConfig 是密码策略文件的路径，为空时只要求最小长度
+	PasswordPolicyConfig string
+}
+
+// NewOptions 创建带有默认值的 Options
+func NewOptions() *Options {
+	storageVersions := schema.GroupVersions{clusterv1alpha1.SchemeGroupVersion, iamv1alpha1.SchemeGroupVersion}
+
+	o := &Options{
+		RecommendedOptions: genericoptions.NewRecommendedOptions(
+			defaultEtcdPathPrefix,
+			apiserver.Codecs.LegacyCodec(storageVersions...),
+		),
+		Token:  NewTokenOptions(),
+		Signup: NewSignupOptions(),
+		Tunnel: NewTunnelOptions(),
+	}
+	o.RecommendedOptions.Etcd.StorageConfig.EncodeVersioner = storageVersions
+	return o
+}
+
+// AddFlags 将所有配置项注册为命令行参数
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	o.RecommendedOptions.AddFlags(fs)
+	o.Token.AddFlags(fs)
+	o.Signup.AddFlags(fs)
+	o.Tunnel.AddFlags(fs)
+	fs.StringSliceVar(&o.AlternateDNS, "alternate-dns", o.AlternateDNS,
+		"Additional DNS names to include in the self-signed serving certificate.")
+	fs.StringVar(&o.PasswordPolicyConfig, "password-policy-config", o.PasswordPolicyConfig,
+		"Path to a YAML file with the password policy enforced when users are created or updated. "+
+			"The kubellm-controller should be given the same file. Only the minimum length is enforced if empty.")
+}
+
+// Complete 补全未显式设置的配置项
+func (o *Options) Complete() error {
+	return nil
+}
+
+// Validate 校验配置项
+func (o *Options) Validate() []error {
+	var errs []error
+	errs = append(errs, o.RecommendedOptions.Validate()...)
+	errs = append(errs, o.Token.Validate()...)
+	errs = append(errs, o.Signup.Validate()...)
+	errs = append(errs, o.Tunnel.Validate()...)
+	return errs
+}
+
+// Config 根据 Options 构造 API Server 配置
+func (o *Options) Config() (*apiserver.Config, error) {
+	if err := o.RecommendedOptions.SecureServing.MaybeDefaultWithSelfSignedCerts("localhost", o.AlternateDNS, []net.IP{netutils.ParseIPSloppy("127.0.0.1")}); err != nil {
+		return nil, fmt.Errorf("error creating self-signed certificates: %v", err)
+	}
+
+	serverConfig := genericapiserver.NewRecommendedConfig(apiserver.Codecs)
+
+	serverConfig.OpenAPIConfig = genericapiserver.DefaultOpenAPIConfig(generatedopenapi.GetOpenAPIDefinitions, openapinamer.NewDefinitionNamer(apiserver.Scheme))
+	serverConfig.OpenAPIConfig.Info.Title = "Kubellm"
+	serverConfig.OpenAPIConfig.Info.Version = "v1alpha1"
+
+	serverConfig.OpenAPIV3Config = genericapiserver.DefaultOpenAPIV3Config(generatedopenapi.GetOpenAPIDefinitions, openapinamer.NewDefinitionNamer(apiserver.Scheme))
+	serverConfig.OpenAPIV3Config.Info.Title = "Kubellm"
+	serverConfig.OpenAPIV3Config.Info.Version = "v1alpha1"
+
+	// proxy 与 tunnel 子资源会长时间保持连接（watch、agent 隧道），不能受普通请求超时的限制
+	serverConfig.LongRunningFunc = genericfilters.BasicLongRunningRequestCheck(
+		sets.NewString("watch", "proxy"),
+		sets.NewString("proxy", "tunnel"),
+	)
+
+	serverConfig.EffectiveVersion = basecompatibility.NewEffectiveVersionFromString(baseversion.DefaultKubeBinaryVersion, "", "")
+
+	// 登录、JWKS 与自注册端点由匿名用户访问，不经过核心集群的 SubjectAccessReview
+	if o.RecommendedOptions.Authorization != nil {
+		o.RecommendedOptions.Authorization.AlwaysAllowPaths = append(o.RecommendedOptions.Authorization.AlwaysAllowPaths, oauth.Paths...)
+		if o.Signup.Enabled {
+			o.RecommendedOptions.Authorization.AlwaysAllowPaths = append(o.RecommendedOptions.Authorization.AlwaysAllowPaths, signup.Paths...)
+		}
+	}
+
+	if err := o.RecommendedOptions.ApplyTo(serverConfig); err != nil {
+		return nil, err
+	}
+
+	passwordPolicy, err := password.LoadPolicy(o.PasswordPolicyConfig)
+	if err != nil {
+		return nil, err
+	}
+	extraConfig := apiserver.ExtraConfig{PasswordPolicy: passwordPolicy}
+	if serverConfig.ClientConfig != nil {
+		kubeClient, err := kubernetes.NewForConfig(serverConfig.ClientConfig)
+		if err != nil {
+			return nil, err
+		}
+		extraConfig.KubeClient = kubeClient
+	}
+	o.Token.ApplyTo(&extraConfig.Token)
+	if err := o.Signup.ApplyTo(&extraConfig.Signup); err != nil {
+		return nil, err
+	}
+	o.Tunnel.ApplyTo(&extraConfig.Tunnel)
+
+	return &apiserver.Config{
+		GenericConfig: serverConfig,
+		ExtraConfig:   extraConfig,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package options
+
+import (
+	"fmt"
+	"net"
+	"net/mail"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	netutils "k8s.io/utils/net"
+
+	"github.com/kubellm-io/kubellm/pkg/apiserver"
+)
+
+// SignupOptions 是本地用户自注册的配置项
+type SignupOptions struct {
+	Enabled bool
+	// TrustedProxies 是转发注册请求的反向代理的 CIDR，只有来自它们的请求才按 X-Forwarded-For 识别客户端 IP
+	TrustedProxies []string
+	// ClientRateLimit 与 RateLimit 分别限制每个客户端 IP 每小时与所有客户端每分钟的注册请求数
+	ClientRateLimit int
+	RateLimit       int
+	// AutoApproveEmailDomains 是验证电子邮件地址后自动通过审批的域名，验证邮件通过 SMTP 服务器发送
+	AutoApproveEmailDomains []string
+	EmailVerificationTTL    time.Duration
+	SMTPAddress             string
+	SMTPFrom                string
+	SMTPUsername            string
+	SMTPPasswordFile        string
+}
+
+// NewSignupOptions 创建带有默认值的 SignupOptions，默认不启用自注册
+func NewSignupOptions() *SignupOptions {
+	return &SignupOptions{
+		ClientRateLimit:      5,
+		RateLimit:            30,
+		EmailVerificationTTL: 24 * time.Hour,
+	}
+}
+
+// AddFlags 将自注册相关的配置项注册为命令行参数
+func (o *SignupOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enabled, "enable-signup", o.Enabled,
+		"Serve an unauthenticated endpoint at /signup where users can register themselves. "+
+			"Registered users are in the PendingApproval state until an administrator approves them through the users/approve subresource.")
+	fs.StringSliceVar(&o.TrustedProxies, "signup-trusted-proxies", o.TrustedProxies,
+		"CIDRs of the reverse proxies in front of the signup endpoint. The client IP used for rate limiting is taken from "+
+			"X-Forwarded-For only for requests coming from these proxies, and from the connection otherwise.")
+	fs.IntVar(&o.ClientRateLimit, "signup-client-rate-limit", o.ClientRateLimit,
+		"The maximum number of signup requests per hour from one client IP.")
+	fs.IntVar(&o.RateLimit, "signup-rate-limit", o.RateLimit,
+		"The maximum number of signup requests per minute from all clients together.")
+	fs.StringSliceVar(&o.AutoApproveEmailDomains, "signup-auto-approve-email-domains", o.AutoApproveEmailDomains,
+		"Email domains whose users are approved without an administrator once they verify their email address. "+
+			"A verification link is sent to registered users in these domains through --signup-smtp-address.")
+	fs.DurationVar(&o.EmailVerificationTTL, "signup-email-verification-ttl", o.EmailVerificationTTL,
+		"How long the link in a verification email stays valid.")
+	fs.StringVar(&o.SMTPAddress, "signup-smtp-address", o.SMTPAddress,
+		"The host:port of the SMTP server that sends verification emails. STARTTLS is used when the server supports it.")
+	fs.StringVar(&o.SMTPFrom, "signup-smtp-from", o.SMTPFrom,
+		"The sender address of verification emails.")
+	fs.StringVar(&o.SMTPUsername, "signup-smtp-username", o.SMTPUsername,
+		"The username for PLAIN authentication to the SMTP server. If empty, no authentication is used.")
+	fs.StringVar(&o.SMTPPasswordFile, "signup-smtp-password-file", o.SMTPPasswordFile,
+		"A file containing the password for PLAIN authentication to the SMTP server.")
+}
+
+// Validate 校验自注册相关的配置项
+func (o *SignupOptions) Validate() []error {
+	if !o.Enabled {
+		return nil
+	}
+	var errs []error
+	if o.ClientRateLimit <= 0 {
+		errs = append(errs, fmt.Errorf("--signup-client-rate-limit must be positive"))
+	}
+	if o.RateLimit <= 0 {
+		errs = append(errs, fmt.Errorf("--signup-rate-limit must be positive"))
+	}
+	for _, cidr := range o.TrustedProxies {
+		if _, _, err := netutils.ParseCIDRSloppy(cidr); err != nil {
+			errs = append(errs, fmt.Errorf("invalid --signup-trusted-proxies %q: %w", cidr, err))
+		}
+	}
+	if len(o.AutoApproveEmailDomains) == 0 {
+		return errs
+	}
+	for _, domain := range o.AutoApproveEmailDomains {
+		if len(domain) == 0 || strings.Contains(domain, "@") {
+			errs = append(errs, fmt.Errorf("--signup-auto-approve-email-domains must contain domain names, got %q", domain))
+		}
+	}
+	if o.EmailVerificationTTL <= 0 {
+		errs = append(errs, fmt.Errorf("--signup-email-verification-ttl must be positive"))
+	}
+	if _, _, err := net.SplitHostPort(o.SMTPAddress); err != nil {
+		errs = append(errs, fmt.Errorf("--signup-smtp-address must be a host:port when --signup-auto-approve-email-domains is set: %w", err))
+	}
+	if _, err := mail.ParseAddress(o.SMTPFrom); err != nil {
+		errs = append(errs, fmt.Errorf("--signup-smtp-from must be an email address when --signup-auto-approve-email-domains is set: %w", err))
+	}
+	if len(o.SMTPPasswordFile) > 0 && len(o.SMTPUsername) == 0 {
+		errs = append(errs, fmt.Errorf("--signup-smtp-password-file requires --signup-smtp-username"))
+	}
+	return errs
+}
+
+// ApplyTo 将配置项写入 API Server 配置，并读取 SMTP 密码文件
+func (o *SignupOptions) ApplyTo(cfg *apiserver.SignupConfig) error {
+	cfg.Enabled = o.Enabled
+	// 已经在 Validate 中校验过
+	cfg.TrustedProxies, _ = netutils.ParseCIDRs(o.TrustedProxies)
+	cfg.ClientRateLimit = o.ClientRateLimit
+	cfg.RateLimit = o.RateLimit
+	cfg.AutoApproveEmailDomains = o.AutoApproveEmailDomains
+	cfg.EmailVerificationTTL = o.EmailVerificationTTL
+	cfg.SMTP = apiserver.SMTPConfig{
+		Address:  o.SMTPAddress,
+		From:     o.SMTPFrom,
+		Username: o.SMTPUsername,
+	}
+	if o.Enabled && len(o.AutoApproveEmailDomains) > 0 && len(o.SMTPPasswordFile) > 0 {
+		password, err := os.ReadFile(o.SMTPPasswordFile)
+		if err != nil {
+			return fmt.Errorf("failed to read --signup-smtp-password-file: %w", err)
+		}
+		cfg.SMTP.Password = strings.TrimSpace(string(password))
+	}
+	return nil
+}
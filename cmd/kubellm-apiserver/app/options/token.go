@@ -0,0 +1,123 @@
+package options
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/spf13/pflag"
+	netutils "k8s.io/utils/net"
+
+	"github.com/kubellm-io/kubellm/pkg/apiserver"
+	userstorage "github.com/kubellm-io/kubellm/pkg/registry/iam/user"
+)
+
+// TokenOptions 是本地用户登录时签发令牌与限制失败登录的配置项
+type TokenOptions struct {
+	Issuer             string
+	Audiences          []string
+	AccessTokenTTL     time.Duration
+	RefreshTokenTTL    time.Duration
+	KeyRotationPeriod  time.Duration
+	KeySecretNamespace string
+	KeySecretName      string
+	// MaxFailedLoginAttempts 与 FailedLoginWindow 决定统计窗口内登录失败多少次后暂时限制用户登录
+	MaxFailedLoginAttempts int32
+	FailedLoginWindow      time.Duration
+	// TrustedProxies 是转发登录请求的反向代理的 CIDR，只有来自它们的请求才按 X-Forwarded-For 识别客户端 IP
+	TrustedProxies []string
+}
+
+// NewTokenOptions 创建带有默认值的 TokenOptions
+func NewTokenOptions() *TokenOptions {
+	return &TokenOptions{
+		Issuer:             "https://kubellm-apiserver.kubellm-system.svc",
+		Audiences:          []string{"kubellm"},
+		AccessTokenTTL:     15 * time.Minute,
+		RefreshTokenTTL:    7 * 24 * time.Hour,
+		KeyRotationPeriod:  24 * time.Hour,
+		KeySecretNamespace: "kubellm-system",
+		KeySecretName:      "kubellm-token-signing-keys",
+
+		MaxFailedLoginAttempts: 5,
+		FailedLoginWindow:      15 * time.Minute,
+	}
+}
+
+// AddFlags 将令牌相关的配置项注册为命令行参数
+func (o *TokenOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Issuer, "token-issuer", o.Issuer,
+		"The issuer URL of the tokens issued at the login endpoint. It must be an https URL at which the OpenID Connect discovery document of the kubellm-apiserver is reachable.")
+	fs.StringSliceVar(&o.Audiences, "token-audiences", o.Audiences,
+		"The audiences of the access tokens issued at the login endpoint.")
+	fs.DurationVar(&o.AccessTokenTTL, "access-token-ttl", o.AccessTokenTTL,
+		"The lifetime of the access tokens issued at the login endpoint.")
+	fs.DurationVar(&o.RefreshTokenTTL, "refresh-token-ttl", o.RefreshTokenTTL,
+		"The lifetime of the refresh tokens issued at the login endpoint.")
+	fs.DurationVar(&o.KeyRotationPeriod, "token-key-rotation-period", o.KeyRotationPeriod,
+		"How often the token signing key is rotated. Retired keys are kept until all tokens signed by them have expired.")
+	fs.StringVar(&o.KeySecretNamespace, "token-key-secret-namespace", o.KeySecretNamespace,
+		"The namespace of the secret in the core cluster that stores the token signing keys.")
+	fs.StringVar(&o.KeySecretName, "token-key-secret-name", o.KeySecretName,
+		"The name of the secret in the core cluster that stores the token signing keys.")
+	fs.Int32Var(&o.MaxFailedLoginAttempts, "max-failed-login-attempts", o.MaxFailedLoginAttempts,
+		"The number of failed password logins within --failed-login-window after which a user is moved to the AuthLimitExceeded state. 0 disables the limit. "+
+			"The kubellm-controller lifts the limit after --user-lockout-duration.")
+	fs.DurationVar(&o.FailedLoginWindow, "failed-login-window", o.FailedLoginWindow,
+		"The window in which failed logins are counted. The count starts over once this duration has passed since the first failure in the window.")
+	fs.StringSliceVar(&o.TrustedProxies, "login-trusted-proxies", o.TrustedProxies,
+		"CIDRs of the reverse proxies in front of the login endpoint. The client IP recorded as the last login IP of a user is taken from "+
+			"X-Forwarded-For only for requests coming from these proxies, and from the connection otherwise.")
+}
+
+// Validate 校验令牌相关的配置项
+func (o *TokenOptions) Validate() []error {
+	var errs []error
+	if u, err := url.Parse(o.Issuer); err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+		errs = append(errs, fmt.Errorf("--token-issuer must be an https URL, got %q", o.Issuer))
+	}
+	if len(o.Audiences) == 0 {
+		errs = append(errs, fmt.Errorf("--token-audiences must not be empty"))
+	}
+	if o.AccessTokenTTL <= 0 {
+		errs = append(errs, fmt.Errorf("--access-token-ttl must be positive"))
+	}
+	if o.RefreshTokenTTL < o.AccessTokenTTL {
+		errs = append(errs, fmt.Errorf("--refresh-token-ttl must not be shorter than --access-token-ttl"))
+	}
+	if o.KeyRotationPeriod < time.Hour {
+		errs = append(errs, fmt.Errorf("--token-key-rotation-period must be at least 1h"))
+	}
+	if len(o.KeySecretNamespace) == 0 || len(o.KeySecretName) == 0 {
+		errs = append(errs, fmt.Errorf("--token-key-secret-namespace and --token-key-secret-name must be set"))
+	}
+	if o.MaxFailedLoginAttempts < 0 {
+		errs = append(errs, fmt.Errorf("--max-failed-login-attempts must not be negative"))
+	}
+	if o.MaxFailedLoginAttempts > 0 && o.FailedLoginWindow <= 0 {
+		errs = append(errs, fmt.Errorf("--failed-login-window must be positive"))
+	}
+	for _, cidr := range o.TrustedProxies {
+		if _, _, err := netutils.ParseCIDRSloppy(cidr); err != nil {
+			errs = append(errs, fmt.Errorf("invalid --login-trusted-proxies %q: %w", cidr, err))
+		}
+	}
+	return errs
+}
+
+// ApplyTo 将配置项写入 API Server 配置
+func (o *TokenOptions) ApplyTo(cfg *apiserver.TokenConfig) {
+	cfg.Issuer = o.Issuer
+	cfg.Audiences = o.Audiences
+	cfg.AccessTokenTTL = o.AccessTokenTTL
+	cfg.RefreshTokenTTL = o.RefreshTokenTTL
+	cfg.KeyRotationPeriod = o.KeyRotationPeriod
+	cfg.KeySecretNamespace = o.KeySecretNamespace
+	cfg.KeySecretName = o.KeySecretName
+	cfg.Lockout = userstorage.LockoutPolicy{
+		MaxFailedAttempts: o.MaxFailedLoginAttempts,
+		FailureWindow:     o.FailedLoginWindow,
+	}
+	// 已经在 Validate 中校验过
+	cfg.TrustedProxies, _ = netutils.ParseCIDRs(o.TrustedProxies)
+}
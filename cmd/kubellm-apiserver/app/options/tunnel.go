@@ -0,0 +1,91 @@
+package options
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	componentbaseconfig "k8s.io/component-base/config"
+
+	"github.com/kubellm-io/kubellm/pkg/apiserver"
+)
+
+// TunnelOptions 是 Pull 模式反向隧道的配置项。
+// 隧道只保存在接受它的副本的内存中，多个副本时通过 Lease 选出唯一活跃的副本维护隧道，
+// 其余副本通过 relay 把隧道与经由隧道的请求转发给活跃的副本。
+type TunnelOptions struct {
+	LeaderElection componentbaseconfig.LeaderElectionConfiguration
+	// RelayBindAddress 与 RelayPort 是副本之间转发请求的 relay 监听的地址
+	RelayBindAddress net.IP
+	RelayPort        int
+}
+
+// NewTunnelOptions 创建带有默认值的 TunnelOptions
+func NewTunnelOptions() *TunnelOptions {
+	return &TunnelOptions{
+		LeaderElection: componentbaseconfig.LeaderElectionConfiguration{
+			LeaderElect:       true,
+			ResourceLock:      "leases",
+			ResourceNamespace: "kubellm-system",
+			ResourceName:      "kubellm-apiserver-tunnel",
+			LeaseDuration:     metav1.Duration{Duration: 15 * time.Second},
+			RenewDeadline:     metav1.Duration{Duration: 10 * time.Second},
+			RetryPeriod:       metav1.Duration{Duration: 2 * time.Second},
+		},
+		RelayBindAddress: net.IPv4zero,
+		RelayPort:        8444,
+	}
+}
+
+// AddFlags 将隧道相关的配置项注册为命令行参数
+func (o *TunnelOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.LeaderElection.LeaderElect, "tunnel-leader-elect", o.LeaderElection.LeaderElect,
+		"Elect one active replica through a lease in the core cluster to hold the agent tunnels. "+
+			"The other replicas relay tunnels and proxy requests to clusters in Pull mode to the active replica. "+
+			"Disable it only when running a single replica.")
+	fs.StringVar(&o.LeaderElection.ResourceNamespace, "tunnel-leader-elect-resource-namespace", o.LeaderElection.ResourceNamespace,
+		"The namespace of the lease that elects the active replica.")
+	fs.StringVar(&o.LeaderElection.ResourceName, "tunnel-leader-elect-resource-name", o.LeaderElection.ResourceName,
+		"The name of the lease that elects the active replica.")
+	fs.DurationVar(&o.LeaderElection.LeaseDuration.Duration, "tunnel-leader-elect-lease-duration", o.LeaderElection.LeaseDuration.Duration,
+		"The duration that standby replicas will wait after observing a renewal until attempting to become active.")
+	fs.DurationVar(&o.LeaderElection.RenewDeadline.Duration, "tunnel-leader-elect-renew-deadline", o.LeaderElection.RenewDeadline.Duration,
+		"The interval between attempts by the active replica to renew the lease before it stops serving.")
+	fs.DurationVar(&o.LeaderElection.RetryPeriod.Duration, "tunnel-leader-elect-retry-period", o.LeaderElection.RetryPeriod.Duration,
+		"The duration the replicas should wait between attempts to acquire and renew the lease.")
+	fs.IPVar(&o.RelayBindAddress, "tunnel-relay-bind-address", o.RelayBindAddress,
+		"The IP address on which to serve the relay that forwards tunnels and proxy requests between replicas.")
+	fs.IntVar(&o.RelayPort, "tunnel-relay-port", o.RelayPort,
+		"The port on which to serve the relay. Replicas reach each other on this port at their --advertise-address, "+
+			"authenticated by a certificate shared through a secret next to the lease.")
+}
+
+// Validate 校验隧道相关的配置项
+func (o *TunnelOptions) Validate() []error {
+	if !o.LeaderElection.LeaderElect {
+		return nil
+	}
+	var errs []error
+	if len(o.LeaderElection.ResourceNamespace) == 0 || len(o.LeaderElection.ResourceName) == 0 {
+		errs = append(errs, fmt.Errorf("--tunnel-leader-elect-resource-namespace and --tunnel-leader-elect-resource-name must be set when leader election is enabled"))
+	}
+	if o.LeaderElection.RenewDeadline.Duration >= o.LeaderElection.LeaseDuration.Duration {
+		errs = append(errs, fmt.Errorf("--tunnel-leader-elect-renew-deadline must be less than --tunnel-leader-elect-lease-duration"))
+	}
+	if o.RelayBindAddress == nil {
+		errs = append(errs, fmt.Errorf("--tunnel-relay-bind-address must be a valid IP address"))
+	}
+	if o.RelayPort <= 0 || o.RelayPort > 65535 {
+		errs = append(errs, fmt.Errorf("--tunnel-relay-port %d must be between 1 and 65535", o.RelayPort))
+	}
+	return errs
+}
+
+// ApplyTo 将配置项写入 API Server 配置
+func (o *TunnelOptions) ApplyTo(cfg *apiserver.TunnelConfig) {
+	cfg.LeaderElection = o.LeaderElection
+	cfg.RelayBindAddress = o.RelayBindAddress
+	cfg.RelayPort = o.RelayPort
+}
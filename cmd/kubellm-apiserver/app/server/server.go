@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/klog/v2"
+
+	"github.com/kubellm-io/kubellm/cmd/kubellm-apiserver/app/options"
+)
+
+// NewAPIServerCommand 创建 kubellm-apiserver 的启动命令
+func NewAPIServerCommand(ctx context.Context) *cobra.Command {
+	opts := options.NewOptions()
+	cmd := &cobra.Command{
+		Use:  "kubellm-apiserver",
+		Long: "The kubellm-apiserver is an aggregated API server that serves the iam.kubellm.io and cluster.kubellm.io APIs.",
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+			if errs := opts.Validate(); len(errs) != 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			return Run(c.Context(), opts)
+		},
+	}
+	cmd.SetContext(ctx)
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// Run 根据配置启动 kubellm-apiserver，直到 ctx 结束
+func Run(ctx context.Context, opts *options.Options) error {
+	config, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	server, err := config.Complete().New()
+	if err != nil {
+		return err
+	}
+
+	server.GenericAPIServer.AddPostStartHookOrDie("start-kubellm-apiserver-informers", func(hookContext genericapiserver.PostStartHookContext) error {
+		if config.GenericConfig.SharedInformerFactory != nil {
+			config.GenericConfig.SharedInformerFactory.Start(hookContext.Done())
+			config.GenericConfig.SharedInformerFactory.WaitForCacheSync(hookContext.Done())
+		}
+		return nil
+	})
+
+	klog.InfoS("Starting kubellm-apiserver")
+	return server.GenericAPIServer.PrepareRun().RunWithContext(ctx)
+}
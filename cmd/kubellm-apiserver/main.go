@@ -0,0 +1,16 @@
+package main
+
+import (
+	"os"
+
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/component-base/cli"
+
+	"github.com/kubellm-io/kubellm/cmd/kubellm-apiserver/app/server"
+)
+
+func main() {
+	ctx := genericapiserver.SetupSignalContext()
+	cmd := server.NewAPIServerCommand(ctx)
+	os.Exit(cli.Run(cmd))
+}
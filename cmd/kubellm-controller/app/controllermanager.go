@@ -0,0 +1,335 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+
+	"github.com/kubellm-io/kubellm/cmd/kubellm-controller/app/options"
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/auth/password"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/impersonation"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/registration"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/remedy"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/status"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/taint"
+	"github.com/kubellm-io/kubellm/pkg/controller/user"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	externalinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+)
+
+// NewControllerManagerCommand 创建 kubellm-controller 的启动命令
+func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
+	opts := options.NewOptions()
+	cmd := &cobra.Command{
+		Use:  "kubellm-controller",
+		Long: "The kubellm-controller runs the controllers that reconcile the iam.kubellm.io and cluster.kubellm.io resources.",
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+			if errs := opts.Validate(); len(errs) != 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			return Run(c.Context(), opts)
+		},
+	}
+	cmd.SetContext(ctx)
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// ControllerContext 是各控制器初始化时共享的依赖
+type ControllerContext struct {
+	Options *options.Options
+
+	KubeClient    kubernetes.Interface
+	KubellmClient versioned.Interface
+
+	KubeInformerFactory    informers.SharedInformerFactory
+	KubellmInformerFactory externalinformers.SharedInformerFactory
+
+	// ClientCache 是各控制器共享的成员集群客户端缓存，凭证来自 Secret 缓存，
+	// 使用前需要等待 KubeInformerFactory 中的 Secret Informer 同步完成
+	ClientCache *membercluster.ClientCache
+}
+
+// InitFunc 初始化并启动一个控制器，控制器应在 ctx 结束时退出
+type InitFunc func(ctx context.Context, controllerCtx ControllerContext) error
+
+// newControllerInitializers 返回所有控制器的名称与初始化函数
+func newControllerInitializers() map[string]InitFunc {
+	return map[string]InitFunc{
+		status.ControllerName:        startClusterStatusController,
+		taint.ControllerName:         startClusterTaintManager,
+		remedy.ControllerName:        startRemedyController,
+		impersonation.ControllerName: startClusterImpersonationController,
+		registration.ControllerName:  startClusterRegistrationController,
+		user.ControllerName:          startUserController,
+	}
+}
+
+// Run 根据配置启动 kubellm-controller，直到 ctx 结束
+func Run(ctx context.Context, opts *options.Options) error {
+	config, err := clientcmd.BuildConfigFromFlags(opts.Master, opts.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to build kubeconfig: %w", err)
+	}
+	config.QPS = opts.KubeAPIQPS
+	config.Burst = opts.KubeAPIBurst
+
+	// estimator 只读取成员集群的状态，所有实例都提供服务，不需要等待成为 leader
+	if len(opts.EstimatorBindAddress) > 0 {
+		if err := startEmbeddedEstimator(ctx, opts, config); err != nil {
+			return err
+		}
+	}
+
+	if !opts.LeaderElection.LeaderElect {
+		return runControllers(ctx, opts, config)
+	}
+
+	id, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	id += "_" + string(uuid.NewUUID())
+
+	lock, err := resourcelock.NewFromKubeconfig(
+		opts.LeaderElection.ResourceLock,
+		opts.LeaderElection.ResourceNamespace,
+		opts.LeaderElection.ResourceName,
+		resourcelock.ResourceLockConfig{Identity: id},
+		rest.AddUserAgent(config, "leader-election"),
+		opts.LeaderElection.RenewDeadline.Duration,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create resource lock: %w", err)
+	}
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   opts.LeaderElection.LeaseDuration.Duration,
+		RenewDeadline:   opts.LeaderElection.RenewDeadline.Duration,
+		RetryPeriod:     opts.LeaderElection.RetryPeriod.Duration,
+		ReleaseOnCancel: true,
+		Name:            opts.LeaderElection.ResourceName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				if err := runControllers(ctx, opts, config); err != nil {
+					klog.ErrorS(err, "Failed to run controllers")
+					os.Exit(1)
+				}
+			},
+			OnStoppedLeading: func() {
+				klog.InfoS("Leader election lost")
+				// 失去领导权后立即退出，避免多个实例同时工作
+				if ctx.Err() == nil {
+					os.Exit(1)
+				}
+			},
+		},
+	})
+	return nil
+}
+
+func runControllers(ctx context.Context, opts *options.Options, config *rest.Config) error {
+	kubeClient, err := kubernetes.NewForConfig(rest.AddUserAgent(config, "kubellm-controller"))
+	if err != nil {
+		return err
+	}
+	kubellmClient, err := versioned.NewForConfig(rest.AddUserAgent(config, "kubellm-controller"))
+	if err != nil {
+		return err
+	}
+
+	controllerCtx := ControllerContext{
+		Options:                opts,
+		KubeClient:             kubeClient,
+		KubellmClient:          kubellmClient,
+		KubeInformerFactory:    informers.NewSharedInformerFactory(kubeClient, 0),
+		KubellmInformerFactory: externalinformers.NewSharedInformerFactory(kubellmClient, 0),
+	}
+	if controllerCtx.ClientCache, err = newClientCache(controllerCtx); err != nil {
+		return err
+	}
+	// 各控制器可以通过这些索引按 provider、region 等字段过滤本地缓存中的集群
+	if err := membercluster.AddClusterIndexers(controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters().Informer()); err != nil {
+		return err
+	}
+
+	for name, initFn := range newControllerInitializers() {
+		klog.InfoS("Starting controller", "controller", name)
+		if err := initFn(ctx, controllerCtx); err != nil {
+			return fmt.Errorf("failed to start controller %q: %w", name, err)
+		}
+	}
+
+	controllerCtx.KubeInformerFactory.Start(ctx.Done())
+	controllerCtx.KubellmInformerFactory.Start(ctx.Done())
+
+	klog.InfoS("Started kubellm-controller")
+	<-ctx.Done()
+	controllerCtx.KubeInformerFactory.Shutdown()
+	controllerCtx.KubellmInformerFactory.Shutdown()
+	return nil
+}
+
+// newClientCache 创建成员集群客户端缓存，Secret 中的凭证变化或 Cluster 被删除时清理对应的缓存项
+func newClientCache(controllerCtx ControllerContext) (*membercluster.ClientCache, error) {
+	secretInformer := controllerCtx.KubeInformerFactory.Core().V1().Secrets()
+	secretLister := secretInformer.Lister()
+	clientCache := membercluster.NewClientCache(func(namespace, name string) (*corev1.Secret, error) {
+		return secretLister.Secrets(namespace).Get(name)
+	}, controllerCtx.Options.ClusterStatusUpdateFrequency)
+
+	if _, err := secretInformer.Informer().AddEventHandler(clientCache.SecretEventHandler()); err != nil {
+		return nil, err
+	}
+	_, err := controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if cluster, ok := obj.(*clusterv1alpha1.Cluster); ok {
+				clientCache.Remove(cluster.Name)
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clientCache, nil
+}
+
+func startClusterStatusController(ctx context.Context, controllerCtx ControllerContext) error {
+	opts := controllerCtx.Options
+	secretInformer := controllerCtx.KubeInformerFactory.Core().V1().Secrets()
+	clientBuilder := status.NewClientBuilder(controllerCtx.ClientCache)
+
+	// Pull 模式集群的状态由成员集群中的 kubellm-agent 上报，控制面只监控它的心跳
+	c, err := status.NewController(
+		controllerCtx.KubellmClient,
+		controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters(),
+		clientBuilder,
+		opts.ClusterStatusUpdateFrequency,
+		func(cluster *clusterv1alpha1.Cluster) bool {
+			return cluster.Spec.SyncMode != clusterv1alpha1.Pull
+		},
+	)
+	if err != nil {
+		return err
+	}
+	monitor, err := status.NewHeartbeatMonitor(
+		controllerCtx.KubellmClient,
+		controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters(),
+		opts.ClusterMonitorGracePeriod,
+	)
+	if err != nil {
+		return err
+	}
+	go monitor.Run(ctx, opts.ConcurrentClusterSyncs)
+	go func() {
+		// 访问成员集群的凭证来自 Secret 缓存，需要等待缓存同步后再开始收集
+		if !cache.WaitForNamedCacheSync(status.ControllerName, ctx.Done(), secretInformer.Informer().HasSynced) {
+			return
+		}
+		c.Run(ctx, opts.ConcurrentClusterSyncs)
+	}()
+	return nil
+}
+
+func startClusterTaintManager(ctx context.Context, controllerCtx ControllerContext) error {
+	clusterInformer := controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters()
+	evictor := taint.NewDeploymentEvictor(clusterInformer.Lister(), taint.ClientBuilderFunc(status.NewClientBuilder(controllerCtx.ClientCache)))
+	c, err := taint.NewController(controllerCtx.KubellmClient, clusterInformer, evictor)
+	if err != nil {
+		return err
+	}
+	go c.Run(ctx, controllerCtx.Options.ConcurrentClusterSyncs)
+	return nil
+}
+
+func startRemedyController(ctx context.Context, controllerCtx ControllerContext) error {
+	c, err := remedy.NewController(
+		controllerCtx.KubellmClient,
+		controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters(),
+		controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Remedies(),
+	)
+	if err != nil {
+		return err
+	}
+	go c.Run(ctx, controllerCtx.Options.ConcurrentClusterSyncs)
+	return nil
+}
+
+func startClusterImpersonationController(ctx context.Context, controllerCtx ControllerContext) error {
+	opts := controllerCtx.Options
+	secretInformer := controllerCtx.KubeInformerFactory.Core().V1().Secrets()
+	clientBuilder := status.NewClientBuilder(controllerCtx.ClientCache)
+
+	c, err := impersonation.NewController(
+		controllerCtx.KubellmClient,
+		controllerCtx.KubeClient,
+		controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters(),
+		controllerCtx.KubellmInformerFactory.Iam().V1alpha1().Users(),
+		impersonation.ClientBuilderFunc(clientBuilder),
+	)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if !cache.WaitForNamedCacheSync(impersonation.ControllerName, ctx.Done(), secretInformer.Informer().HasSynced) {
+			return
+		}
+		c.Run(ctx, opts.ConcurrentClusterSyncs)
+	}()
+	return nil
+}
+
+func startClusterRegistrationController(ctx context.Context, controllerCtx ControllerContext) error {
+	c, err := registration.NewController(
+		controllerCtx.KubeClient,
+		controllerCtx.KubeInformerFactory.Certificates().V1().CertificateSigningRequests(),
+		controllerCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters(),
+	)
+	if err != nil {
+		return err
+	}
+	go c.Run(ctx, controllerCtx.Options.ConcurrentClusterSyncs)
+	return nil
+}
+
+func startUserController(ctx context.Context, controllerCtx ControllerContext) error {
+	passwordPolicy, err := password.LoadPolicy(controllerCtx.Options.PasswordPolicyConfig)
+	if err != nil {
+		return err
+	}
+	c, err := user.NewController(
+		controllerCtx.KubeClient,
+		controllerCtx.KubellmClient,
+		controllerCtx.KubellmInformerFactory.Iam().V1alpha1().Users(),
+		controllerCtx.Options.UserLockoutDuration,
+		passwordPolicy,
+	)
+	if err != nil {
+		return err
+	}
+	go c.Run(ctx, controllerCtx.Options.ConcurrentUserSyncs)
+	return nil
+}
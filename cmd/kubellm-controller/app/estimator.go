@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/kubellm-io/kubellm/cmd/kubellm-controller/app/options"
+	"github.com/kubellm-io/kubellm/pkg/estimator/server"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	externalinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions"
+)
+
+// startEmbeddedEstimator 启动为 Push 模式集群提供服务的 estimator。
+// 它不依赖 leader 选举，因此使用独立的 Informer 与成员集群客户端缓存。
+func startEmbeddedEstimator(ctx context.Context, opts *options.Options, config *rest.Config) error {
+	kubeClient, err := kubernetes.NewForConfig(rest.AddUserAgent(config, "kubellm-estimator"))
+	if err != nil {
+		return err
+	}
+	kubellmClient, err := versioned.NewForConfig(rest.AddUserAgent(config, "kubellm-estimator"))
+	if err != nil {
+		return err
+	}
+
+	creds, err := server.ServerCredentials(opts.EstimatorTLSCertFile, opts.EstimatorTLSPrivateKeyFile, opts.EstimatorClientCAFile)
+	if err != nil {
+		return fmt.Errorf("failed to load estimator credentials: %w", err)
+	}
+
+	estimatorCtx := ControllerContext{
+		Options:                opts,
+		KubeClient:             kubeClient,
+		KubellmClient:          kubellmClient,
+		KubeInformerFactory:    informers.NewSharedInformerFactory(kubeClient, 0),
+		KubellmInformerFactory: externalinformers.NewSharedInformerFactory(kubellmClient, 0),
+	}
+	if estimatorCtx.ClientCache, err = newClientCache(estimatorCtx); err != nil {
+		return err
+	}
+	secretInformer := estimatorCtx.KubeInformerFactory.Core().V1().Secrets().Informer()
+	clusterInformer := estimatorCtx.KubellmInformerFactory.Cluster().V1alpha1().Clusters()
+	snapshot := server.NewMemberClusterSnapshot(clusterInformer.Lister(), estimatorCtx.ClientCache)
+
+	lis, err := net.Listen("tcp", opts.EstimatorBindAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", opts.EstimatorBindAddress, err)
+	}
+
+	estimatorCtx.KubeInformerFactory.Start(ctx.Done())
+	estimatorCtx.KubellmInformerFactory.Start(ctx.Done())
+	go func() {
+		defer lis.Close()
+		if !cache.WaitForNamedCacheSync("embedded-estimator", ctx.Done(), secretInformer.HasSynced, clusterInformer.Informer().HasSynced) {
+			return
+		}
+		if err := server.Serve(ctx, lis, server.NewServer(snapshot), grpc.Creds(creds)); err != nil {
+			klog.ErrorS(err, "Embedded estimator exited")
+		}
+	}()
+	return nil
+}
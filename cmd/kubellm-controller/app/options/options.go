@@ -0,0 +1,162 @@
+package options
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	componentbaseconfig "k8s.io/component-base/config"
+)
+
+const (
+	defaultLeaderElectionNamespace = "kubellm-system"
+	defaultLeaderElectionName      = "kubellm-controller"
+)
+
+// Options 是 kubellm-controller 的统一配置源，所有命令行参数都在这里定义
+type Options struct {
+	// Master 是 kube-apiserver 的地址，会覆盖 kubeconfig 中的地址
+	Master string
+	// Kubeconfig 是访问宿主集群的 kubeconfig 路径，为空时使用 in-cluster 配置
+	Kubeconfig string
+	// KubeAPIQPS 与 KubeAPIBurst 限制访问宿主集群的速率
+	KubeAPIQPS   float32
+	KubeAPIBurst int
+
+	LeaderElection componentbaseconfig.LeaderElectionConfiguration
+
+	// ClusterStatusUpdateFrequency 是集群状态控制器收集成员集群状态的间隔
+	ClusterStatusUpdateFrequency time.Duration
+	// ConcurrentClusterSyncs 是集群状态控制器并发处理的集群数量
+	ConcurrentClusterSyncs int
+	// ClusterMonitorGracePeriod 是 Pull 模式集群的 kubellm-agent 停止上报后，集群被标记为 Unknown 前的等待时间
+	ClusterMonitorGracePeriod time.Duration
+
+	// UserLockoutDuration 是用户因登录失败次数过多被限制登录后自动解除限制前的冷却时间
+	UserLockoutDuration time.Duration
+	// ConcurrentUserSyncs 是 User 控制器并发处理的用户数量
+	ConcurrentUserSyncs int
+	// PasswordPolicyConfig 是密码策略文件的路径，其中的 maxAge 决定密码的过期时间，为空时密码永不过期
+	PasswordPolicyConfig string
+
+	// EstimatorBindAddress 是嵌入的 estimator gRPC 服务监听的地址，为空时不启动
+	EstimatorBindAddress string
+	// EstimatorTLSCertFile 与 EstimatorTLSPrivateKeyFile 是 estimator 使用的证书与私钥
+	EstimatorTLSCertFile       string
+	EstimatorTLSPrivateKeyFile string
+	// EstimatorClientCAFile 是签发调度器客户端证书的 CA，estimator 只接受由它签发的客户端证书
+	EstimatorClientCAFile string
+}
+
+// NewOptions 创建带有默认值的 Options
+func NewOptions() *Options {
+	return &Options{
+		KubeAPIQPS:   40,
+		KubeAPIBurst: 60,
+		LeaderElection: componentbaseconfig.LeaderElectionConfiguration{
+			LeaderElect:       true,
+			ResourceLock:      "leases",
+			ResourceNamespace: defaultLeaderElectionNamespace,
+			ResourceName:      defaultLeaderElectionName,
+			LeaseDuration:     metav1.Duration{Duration: 15 * time.Second},
+			RenewDeadline:     metav1.Duration{Duration: 10 * time.Second},
+			RetryPeriod:       metav1.Duration{Duration: 2 * time.Second},
+		},
+		ClusterStatusUpdateFrequency: 10 * time.Second,
+		ConcurrentClusterSyncs:       5,
+		ClusterMonitorGracePeriod:    40 * time.Second,
+		UserLockoutDuration:          15 * time.Minute,
+		ConcurrentUserSyncs:          5,
+	}
+}
+
+// AddFlags 将所有配置项注册为命令行参数
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Master, "master", o.Master,
+		"The address of the Kubernetes API server. Overrides any value in kubeconfig.")
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig,
+		"Path to kubeconfig file with authorization and master location information. Uses in-cluster config if empty.")
+	fs.Float32Var(&o.KubeAPIQPS, "kube-api-qps", o.KubeAPIQPS, "QPS to use while talking with the Kubernetes API server.")
+	fs.IntVar(&o.KubeAPIBurst, "kube-api-burst", o.KubeAPIBurst, "Burst to use while talking with the Kubernetes API server.")
+
+	fs.BoolVar(&o.LeaderElection.LeaderElect, "leader-elect", o.LeaderElection.LeaderElect,
+		"Start a leader election client and gain leadership before executing the main loop.")
+	fs.StringVar(&o.LeaderElection.ResourceNamespace, "leader-elect-resource-namespace", o.LeaderElection.ResourceNamespace,
+		"The namespace of resource object that is used for locking during leader election.")
+	fs.StringVar(&o.LeaderElection.ResourceName, "leader-elect-resource-name", o.LeaderElection.ResourceName,
+		"The name of resource object that is used for locking during leader election.")
+	fs.DurationVar(&o.LeaderElection.LeaseDuration.Duration, "leader-elect-lease-duration", o.LeaderElection.LeaseDuration.Duration,
+		"The duration that non-leader candidates will wait after observing a leadership renewal until attempting to acquire leadership.")
+	fs.DurationVar(&o.LeaderElection.RenewDeadline.Duration, "leader-elect-renew-deadline", o.LeaderElection.RenewDeadline.Duration,
+		"The interval between attempts by the acting master to renew a leadership slot before it stops leading.")
+	fs.DurationVar(&o.LeaderElection.RetryPeriod.Duration, "leader-elect-retry-period", o.LeaderElection.RetryPeriod.Duration,
+		"The duration the clients should wait between attempting acquisition and renewal of a leadership.")
+
+	fs.DurationVar(&o.ClusterStatusUpdateFrequency, "cluster-status-update-frequency", o.ClusterStatusUpdateFrequency,
+		"Specifies how often the controller collects the status of member clusters.")
+	fs.IntVar(&o.ConcurrentClusterSyncs, "concurrent-cluster-syncs", o.ConcurrentClusterSyncs,
+		"The number of clusters that are allowed to sync concurrently.")
+	fs.DurationVar(&o.ClusterMonitorGracePeriod, "cluster-monitor-grace-period", o.ClusterMonitorGracePeriod,
+		"The duration a cluster in Pull mode may go without a status report from its kubellm-agent before its Ready condition is set to Unknown. "+
+			"It must be several times the --cluster-status-update-frequency of the agents.")
+
+	fs.DurationVar(&o.UserLockoutDuration, "user-lockout-duration", o.UserLockoutDuration,
+		"How long a user stays in the AuthLimitExceeded state after too many failed logins before the limit is lifted automatically.")
+	fs.IntVar(&o.ConcurrentUserSyncs, "concurrent-user-syncs", o.ConcurrentUserSyncs,
+		"The number of users that are allowed to sync concurrently.")
+	fs.StringVar(&o.PasswordPolicyConfig, "password-policy-config", o.PasswordPolicyConfig,
+		"Path to the password policy file given to the kubellm-apiserver. Its maxAge decides when passwords expire. Passwords never expire if empty.")
+
+	fs.StringVar(&o.EstimatorBindAddress, "estimator-bind-address", o.EstimatorBindAddress,
+		"The address on which to serve the embedded estimator gRPC service for Push mode clusters. The estimator is disabled if empty.")
+	fs.StringVar(&o.EstimatorTLSCertFile, "estimator-tls-cert-file", o.EstimatorTLSCertFile,
+		"File containing the x509 certificate for the embedded estimator.")
+	fs.StringVar(&o.EstimatorTLSPrivateKeyFile, "estimator-tls-private-key-file", o.EstimatorTLSPrivateKeyFile,
+		"File containing the x509 private key matching --estimator-tls-cert-file.")
+	fs.StringVar(&o.EstimatorClientCAFile, "estimator-client-ca-file", o.EstimatorClientCAFile,
+		"File containing the CA certificates used by the embedded estimator to verify client certificates.")
+}
+
+// Complete 补全未显式设置的配置项
+func (o *Options) Complete() error {
+	return nil
+}
+
+// Validate 校验配置项
+func (o *Options) Validate() []error {
+	var errs []error
+	if o.ClusterStatusUpdateFrequency <= 0 {
+		errs = append(errs, fmt.Errorf("--cluster-status-update-frequency must be greater than 0"))
+	}
+	if o.ConcurrentClusterSyncs <= 0 {
+		errs = append(errs, fmt.Errorf("--concurrent-cluster-syncs must be greater than 0"))
+	}
+	if o.ClusterMonitorGracePeriod <= 0 {
+		errs = append(errs, fmt.Errorf("--cluster-monitor-grace-period must be greater than 0"))
+	}
+	if o.UserLockoutDuration <= 0 {
+		errs = append(errs, fmt.Errorf("--user-lockout-duration must be greater than 0"))
+	}
+	if o.ConcurrentUserSyncs <= 0 {
+		errs = append(errs, fmt.Errorf("--concurrent-user-syncs must be greater than 0"))
+	}
+	if len(o.EstimatorBindAddress) > 0 {
+		if _, _, err := net.SplitHostPort(o.EstimatorBindAddress); err != nil {
+			errs = append(errs, fmt.Errorf("invalid --estimator-bind-address %q: %w", o.EstimatorBindAddress, err))
+		}
+		if len(o.EstimatorTLSCertFile) == 0 || len(o.EstimatorTLSPrivateKeyFile) == 0 || len(o.EstimatorClientCAFile) == 0 {
+			errs = append(errs, fmt.Errorf("--estimator-tls-cert-file, --estimator-tls-private-key-file and --estimator-client-ca-file must be set when the embedded estimator is enabled"))
+		}
+	}
+	if o.LeaderElection.LeaderElect {
+		if len(o.LeaderElection.ResourceNamespace) == 0 || len(o.LeaderElection.ResourceName) == 0 {
+			errs = append(errs, fmt.Errorf("--leader-elect-resource-namespace and --leader-elect-resource-name must be set when leader election is enabled"))
+		}
+		if o.LeaderElection.RenewDeadline.Duration >= o.LeaderElection.LeaseDuration.Duration {
+			errs = append(errs, fmt.Errorf("--leader-elect-renew-deadline must be less than --leader-elect-lease-duration"))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubellm-io/kubellm/cmd/kubellm-estimator/app/options"
+	"github.com/kubellm-io/kubellm/pkg/estimator/server"
+)
+
+// NewEstimatorCommand 创建 kubellm-estimator 的启动命令
+func NewEstimatorCommand(ctx context.Context) *cobra.Command {
+	opts := options.NewOptions()
+	cmd := &cobra.Command{
+		Use: "kubellm-estimator",
+		Long: `The kubellm-estimator runs in a member cluster and serves a gRPC service that estimates how many replicas
+of a pod template fit in the cluster, based on the allocatable resources, taints and labels of the nodes.`,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+			if errs := opts.Validate(); len(errs) != 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			return Run(c.Context(), opts)
+		},
+	}
+	cmd.SetContext(ctx)
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// Run 根据配置启动 kubellm-estimator，直到 ctx 结束
+func Run(ctx context.Context, opts *options.Options) error {
+	config, err := clientcmd.BuildConfigFromFlags("", opts.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to build kubeconfig: %w", err)
+	}
+	config.QPS = opts.KubeAPIQPS
+	config.Burst = opts.KubeAPIBurst
+	kubeClient, err := kubernetes.NewForConfig(rest.AddUserAgent(config, "kubellm-estimator"))
+	if err != nil {
+		return err
+	}
+
+	creds, err := server.ServerCredentials(opts.TLSCertFile, opts.TLSPrivateKeyFile, opts.ClientCAFile)
+	if err != nil {
+		return err
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	// 已结束的 Pod 不占用节点资源，不需要缓存
+	podInformerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 0,
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = "status.phase!=Succeeded,status.phase!=Failed"
+		}))
+	nodeInformer := informerFactory.Core().V1().Nodes()
+	podInformer := podInformerFactory.Core().V1().Pods()
+	snapshot := server.NewListerSnapshot(opts.ClusterName, nodeInformer.Lister(), podInformer.Lister())
+
+	informerFactory.Start(ctx.Done())
+	podInformerFactory.Start(ctx.Done())
+	if !cache.WaitForNamedCacheSync("kubellm-estimator", ctx.Done(), nodeInformer.Informer().HasSynced, podInformer.Informer().HasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	lis, err := net.Listen("tcp", opts.BindAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", opts.BindAddress, err)
+	}
+	return server.Serve(ctx, lis, server.NewServer(snapshot), grpc.Creds(creds))
+}
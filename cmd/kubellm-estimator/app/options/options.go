@@ -0,0 +1,78 @@
+package options
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+)
+
+// Options 是 kubellm-estimator 的统一配置源，所有命令行参数都在这里定义
+type Options struct {
+	// Kubeconfig 是访问成员集群的 kubeconfig 路径，为空时使用 in-cluster 配置
+	Kubeconfig string
+	// KubeAPIQPS 与 KubeAPIBurst 限制访问成员集群的速率
+	KubeAPIQPS   float32
+	KubeAPIBurst int
+
+	// ClusterName 是成员集群注册到控制面的 Cluster 名称，estimator 只回答该集群的请求
+	ClusterName string
+
+	// BindAddress 是 gRPC 服务监听的地址
+	BindAddress string
+	// TLSCertFile 与 TLSPrivateKeyFile 是 gRPC 服务使用的证书与私钥
+	TLSCertFile       string
+	TLSPrivateKeyFile string
+	// ClientCAFile 是签发调度器客户端证书的 CA，estimator 只接受由它签发的客户端证书
+	ClientCAFile string
+}
+
+// NewOptions 创建带有默认值的 Options
+func NewOptions() *Options {
+	return &Options{
+		KubeAPIQPS:   40,
+		KubeAPIBurst: 60,
+		BindAddress:  ":10352",
+	}
+}
+
+// AddFlags 将所有配置项注册为命令行参数
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig,
+		"Path to the kubeconfig file of the member cluster. Uses in-cluster config if empty.")
+	fs.Float32Var(&o.KubeAPIQPS, "kube-api-qps", o.KubeAPIQPS, "QPS to use while talking with the Kubernetes API server.")
+	fs.IntVar(&o.KubeAPIBurst, "kube-api-burst", o.KubeAPIBurst, "Burst to use while talking with the Kubernetes API server.")
+
+	fs.StringVar(&o.ClusterName, "cluster-name", o.ClusterName, "Name of the member cluster registered to the kubellm control plane.")
+
+	fs.StringVar(&o.BindAddress, "bind-address", o.BindAddress, "The address on which to serve the estimator gRPC service.")
+	fs.StringVar(&o.TLSCertFile, "tls-cert-file", o.TLSCertFile,
+		"File containing the x509 certificate for the gRPC service.")
+	fs.StringVar(&o.TLSPrivateKeyFile, "tls-private-key-file", o.TLSPrivateKeyFile,
+		"File containing the x509 private key matching --tls-cert-file.")
+	fs.StringVar(&o.ClientCAFile, "client-ca-file", o.ClientCAFile,
+		"File containing the CA certificates used to verify client certificates. Only clients presenting a certificate signed by one of them are served.")
+}
+
+// Complete 补全未显式设置的配置项
+func (o *Options) Complete() error {
+	return nil
+}
+
+// Validate 校验配置项
+func (o *Options) Validate() []error {
+	var errs []error
+	if len(o.ClusterName) == 0 {
+		errs = append(errs, fmt.Errorf("--cluster-name must be set"))
+	} else if msgs := path.ValidatePathSegmentName(o.ClusterName, false); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid --cluster-name %q: %v", o.ClusterName, msgs))
+	}
+	if _, _, err := net.SplitHostPort(o.BindAddress); err != nil {
+		errs = append(errs, fmt.Errorf("invalid --bind-address %q: %w", o.BindAddress, err))
+	}
+	if len(o.TLSCertFile) == 0 || len(o.TLSPrivateKeyFile) == 0 || len(o.ClientCAFile) == 0 {
+		errs = append(errs, fmt.Errorf("--tls-cert-file, --tls-private-key-file and --client-ca-file must be set"))
+	}
+	return errs
+}
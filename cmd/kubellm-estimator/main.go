@@ -0,0 +1,16 @@
+package main
+
+import (
+	"os"
+
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/component-base/cli"
+
+	"github.com/kubellm-io/kubellm/cmd/kubellm-estimator/app"
+)
+
+func main() {
+	ctx := genericapiserver.SetupSignalContext()
+	cmd := app.NewEstimatorCommand(ctx)
+	os.Exit(cli.Run(cmd))
+}
@@ -0,0 +1,304 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/impersonation"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+)
+
+const (
+	// memberNamespace 是成员集群中存放 kubellm 相关对象的命名空间，与 impersonator 共用
+	memberNamespace = impersonation.ImpersonatorNamespace
+	// memberServiceAccountName 是控制面访问成员集群使用的 ServiceAccount 名称，
+	// 同名的 ClusterRole 与 ClusterRoleBinding 授予它成员集群的全部权限
+	memberServiceAccountName = "kubellm-control-plane"
+	// memberTokenSecretName 是成员集群中保存 memberServiceAccountName token 的 Secret 名称
+	memberTokenSecretName = memberServiceAccountName + "-token"
+
+	// defaultClusterNamespace 是控制面中保存成员集群凭证的默认命名空间
+	defaultClusterNamespace = "kubellm-system"
+)
+
+// JoinOptions 是 join 命令的参数
+type JoinOptions struct {
+	// Kubeconfig 与 Context 指定访问 kubellm 控制面的 kubeconfig
+	Kubeconfig string
+	Context    string
+
+	// ClusterName 是注册到控制面的 Cluster 名称
+	ClusterName string
+	// ClusterKubeconfig 与 ClusterContext 指定访问成员集群的 kubeconfig，仅在 join 时使用，不会保存到控制面
+	ClusterKubeconfig string
+	ClusterContext    string
+	// ClusterNamespace 是控制面中保存成员集群凭证的命名空间
+	ClusterNamespace string
+	// ClusterProvider 与 ClusterRegion 会写入 Cluster
+	ClusterProvider string
+	ClusterRegion   string
+
+	// Timeout 是等待成员集群生成 ServiceAccount token 的最长时间
+	Timeout time.Duration
+}
+
+// NewJoinCommand 创建 join 命令
+func NewJoinCommand() *cobra.Command {
+	opts := &JoinOptions{
+		ClusterNamespace: defaultClusterNamespace,
+		Timeout:          time.Minute,
+	}
+	cmd := &cobra.Command{
+		Use:   "join <name> --cluster-kubeconfig=<path>",
+		Short: "Register a member cluster to the kubellm control plane in Push mode",
+		Long: `Register a member cluster to the kubellm control plane in Push mode.
+
+It creates a ServiceAccount with full access in the member cluster, stores its token and the CA bundle
+of the member cluster in a Secret of the control plane, and creates the Cluster object referencing the Secret.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.ClusterName = args[0]
+			if errs := opts.Validate(); len(errs) != 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			if err := opts.Run(c.Context()); err != nil {
+				return err
+			}
+			fmt.Fprintf(c.OutOrStdout(), "cluster %q joined\n", opts.ClusterName)
+			return nil
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// AddFlags 将 join 的参数注册为命令行参数
+func (o *JoinOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "Path to the kubeconfig file of the kubellm control plane.")
+	fs.StringVar(&o.Context, "context", o.Context, "Name of the kubeconfig context to use for the kubellm control plane.")
+	fs.StringVar(&o.ClusterKubeconfig, "cluster-kubeconfig", o.ClusterKubeconfig, "Path to the kubeconfig file of the member cluster.")
+	fs.StringVar(&o.ClusterContext, "cluster-context", o.ClusterContext, "Name of the kubeconfig context to use for the member cluster.")
+	fs.StringVar(&o.ClusterNamespace, "cluster-namespace", o.ClusterNamespace,
+		"Namespace of the control plane in which the credentials of the member cluster are stored.")
+	fs.StringVar(&o.ClusterProvider, "cluster-provider", o.ClusterProvider, "Cloud provider name of the member cluster.")
+	fs.StringVar(&o.ClusterRegion, "cluster-region", o.ClusterRegion, "Region in which the member cluster is located.")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait for the token of the member cluster ServiceAccount to be populated.")
+}
+
+// Validate 校验 join 的参数
+func (o *JoinOptions) Validate() []error {
+	var errs []error
+	if msgs := path.ValidatePathSegmentName(o.ClusterName, false); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid cluster name %q: %v", o.ClusterName, msgs))
+	}
+	if len(o.ClusterKubeconfig) == 0 {
+		errs = append(errs, fmt.Errorf("--cluster-kubeconfig must be set"))
+	}
+	if len(o.ClusterNamespace) == 0 {
+		errs = append(errs, fmt.Errorf("--cluster-namespace must be set"))
+	}
+	if o.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("--timeout must be greater than 0"))
+	}
+	return errs
+}
+
+// Run 注册成员集群。
+// 所有步骤都可以重复执行，中途失败后重新运行 join 即可继续；Cluster 已存在时直接报错，不会覆盖已注册的集群。
+func (o *JoinOptions) Run(ctx context.Context) error {
+	controlPlaneConfig, err := buildConfig(o.Kubeconfig, o.Context)
+	if err != nil {
+		return fmt.Errorf("failed to build control plane kubeconfig: %w", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(controlPlaneConfig)
+	if err != nil {
+		return err
+	}
+	kubellmClient, err := versioned.NewForConfig(controlPlaneConfig)
+	if err != nil {
+		return err
+	}
+
+	_, err = kubellmClient.ClusterV1alpha1().Clusters().Get(ctx, o.ClusterName, metav1.GetOptions{})
+	if err == nil {
+		return fmt.Errorf("cluster %s is already joined", o.ClusterName)
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get cluster %s: %w", o.ClusterName, err)
+	}
+
+	memberConfig, err := buildConfig(o.ClusterKubeconfig, o.ClusterContext)
+	if err != nil {
+		return fmt.Errorf("failed to build member cluster kubeconfig: %w", err)
+	}
+	memberClient, err := kubernetes.NewForConfig(memberConfig)
+	if err != nil {
+		return err
+	}
+
+	id, err := membercluster.GetClusterID(ctx, memberClient)
+	if err != nil {
+		return err
+	}
+	if err := ensureMemberServiceAccount(ctx, memberClient); err != nil {
+		return err
+	}
+	tokenSecret, err := waitForMemberToken(ctx, memberClient, o.Timeout)
+	if err != nil {
+		return err
+	}
+	caBundle, err := memberCABundle(memberConfig, tokenSecret)
+	if err != nil {
+		return err
+	}
+
+	secretRef := &clusterv1alpha1.LocalSecretReference{Namespace: o.ClusterNamespace, Name: o.ClusterName}
+	if err := ensureClusterSecret(ctx, kubeClient, secretRef, tokenSecret.Data[corev1.ServiceAccountTokenKey], caBundle); err != nil {
+		return err
+	}
+
+	cluster := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: o.ClusterName},
+		Spec: clusterv1alpha1.ClusterSpec{
+			ID:                          id,
+			SyncMode:                    clusterv1alpha1.Push,
+			APIEndpoint:                 memberConfig.Host,
+			SecretRef:                   secretRef,
+			InsecureSkipTLSVerification: memberConfig.Insecure,
+			Provider:                    o.ClusterProvider,
+			Region:                      o.ClusterRegion,
+		},
+	}
+	if _, err := kubellmClient.ClusterV1alpha1().Clusters().Create(ctx, cluster, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create cluster %s: %w", o.ClusterName, err)
+	}
+	return nil
+}
+
+// memberClusterRules 是控制面在成员集群中拥有的权限
+var memberClusterRules = []rbacv1.PolicyRule{
+	{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+	{NonResourceURLs: []string{"*"}, Verbs: []string{"get"}},
+}
+
+// ensureMemberServiceAccount 在成员集群中创建控制面使用的命名空间、ServiceAccount、ClusterRole、
+// ClusterRoleBinding 以及 token Secret，已存在的对象保持不变
+func ensureMemberServiceAccount(ctx context.Context, client kubernetes.Interface) error {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: memberNamespace}}
+	if _, err := client.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %w", memberNamespace, err)
+	}
+
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: memberNamespace, Name: memberServiceAccountName}}
+	if _, err := client.CoreV1().ServiceAccounts(memberNamespace).Create(ctx, serviceAccount, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create service account %s/%s: %w", memberNamespace, memberServiceAccountName, err)
+	}
+
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: memberServiceAccountName}, Rules: memberClusterRules}
+	if _, err := client.RbacV1().ClusterRoles().Create(ctx, clusterRole, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create cluster role %s: %w", memberServiceAccountName, err)
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: memberServiceAccountName},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: memberServiceAccountName},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: memberNamespace, Name: memberServiceAccountName}},
+	}
+	if _, err := client.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create cluster role binding %s: %w", memberServiceAccountName, err)
+	}
+
+	// 1.24 之后 ServiceAccount 不再自动生成 token Secret，这里显式创建，由成员集群的 token 控制器填充
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   memberNamespace,
+			Name:        memberTokenSecretName,
+			Annotations: map[string]string{corev1.ServiceAccountNameKey: memberServiceAccountName},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+	if _, err := client.CoreV1().Secrets(memberNamespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create secret %s/%s: %w", memberNamespace, memberTokenSecretName, err)
+	}
+	return nil
+}
+
+// waitForMemberToken 等待成员集群的 token 控制器填充 token Secret
+func waitForMemberToken(ctx context.Context, client kubernetes.Interface, timeout time.Duration) (*corev1.Secret, error) {
+	var secret *corev1.Secret
+	err := wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		var err error
+		secret, err = client.CoreV1().Secrets(memberNamespace).Get(ctx, memberTokenSecretName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return len(secret.Data[corev1.ServiceAccountTokenKey]) > 0, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for the token of secret %s/%s: %w", memberNamespace, memberTokenSecretName, err)
+	}
+	return secret, nil
+}
+
+// memberCABundle 返回校验成员集群证书使用的 CA。
+// 优先使用 kubeconfig 中的 CA；kubeconfig 没有指定 CA 且未跳过校验时，使用 ServiceAccount token Secret 中的集群 CA。
+func memberCABundle(config *rest.Config, tokenSecret *corev1.Secret) ([]byte, error) {
+	config = rest.CopyConfig(config)
+	if err := rest.LoadTLSFiles(config); err != nil {
+		return nil, fmt.Errorf("failed to load CA of member cluster: %w", err)
+	}
+	if len(config.CAData) > 0 || config.Insecure {
+		return config.CAData, nil
+	}
+	return tokenSecret.Data[corev1.ServiceAccountRootCAKey], nil
+}
+
+// ensureClusterSecret 在控制面中创建或更新保存成员集群凭证的 Secret
+func ensureClusterSecret(ctx context.Context, client kubernetes.Interface, ref *clusterv1alpha1.LocalSecretReference, token, caBundle []byte) error {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ref.Namespace}}
+	if _, err := client.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %w", ref.Namespace, err)
+	}
+
+	data := map[string][]byte{clusterv1alpha1.SecretTokenKey: token}
+	if len(caBundle) > 0 {
+		data[clusterv1alpha1.SecretCADataKey] = caBundle
+	}
+
+	secrets := client.CoreV1().Secrets(ref.Namespace)
+	existing, err := secrets.Get(ctx, ref.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name}, Data: data}
+		if _, err := secrets.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+
+	// 上次 join 中途失败时留下的 Secret，使用本次获取的凭证覆盖
+	secret := existing.DeepCopy()
+	secret.Data = data
+	if _, err := secrets.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// NewKubellmctlCommand 创建 kubellmctl 的根命令
+func NewKubellmctlCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kubellmctl",
+		Short: "kubellmctl controls the kubellm control plane",
+		RunE: func(c *cobra.Command, args []string) error {
+			return c.Help()
+		},
+	}
+	cmd.SetContext(ctx)
+
+	cmd.AddCommand(NewJoinCommand())
+	cmd.AddCommand(NewUnjoinCommand())
+	cmd.AddCommand(NewTokenCommand())
+	cmd.AddCommand(NewRegisterCommand())
+	return cmd
+}
+
+// buildConfig 根据 kubeconfig 路径与 context 构造 rest.Config，路径为空时按 kubectl 的默认规则查找
+func buildConfig(kubeconfig, context string) (*rest.Config, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: context},
+	).ClientConfig()
+}
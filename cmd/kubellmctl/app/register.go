@@ -0,0 +1,271 @@
+package app
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/certificate/csr"
+	"k8s.io/client-go/util/keyutil"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/registration"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+)
+
+const (
+	// agentKubeconfigSecretName 是成员集群中保存 kubellm-agent 访问控制面 kubeconfig 的 Secret 名称
+	agentKubeconfigSecretName = "kubellm-agent-kubeconfig"
+	// agentKubeconfigKey 是 kubeconfig 在 Secret 中的键
+	agentKubeconfigKey = "kubeconfig"
+)
+
+// RegisterOptions 是 register 命令的参数
+type RegisterOptions struct {
+	// ControlPlaneServer 与 ControlPlaneCAFile 指定 kubellm 控制面的地址与 CA
+	ControlPlaneServer string
+	ControlPlaneCAFile string
+	// Token 是 kubellmctl token create 创建的 bootstrap token
+	Token string
+	// Kubeconfig 是访问成员集群的 kubeconfig 路径，为空时使用 in-cluster 配置
+	Kubeconfig string
+
+	// ClusterName 是注册到控制面的 Cluster 名称
+	ClusterName string
+	// ClusterProvider 与 ClusterRegion 会写入 Cluster。bootstrap token 不能设置 apiEndpoint，
+	// 控制面经由 agent 的隧道访问 Pull 模式的集群
+	ClusterProvider string
+	ClusterRegion   string
+
+	// Timeout 是等待 CSR 被批准并签发证书的最长时间
+	Timeout time.Duration
+}
+
+// NewRegisterCommand 创建 register 命令
+func NewRegisterCommand() *cobra.Command {
+	opts := &RegisterOptions{Timeout: 5 * time.Minute}
+	cmd := &cobra.Command{
+		Use:   "register <name> --control-plane-server=<url> --token=<token>",
+		Short: "Register the current cluster to the kubellm control plane in Pull mode with a bootstrap token",
+		Long: `Register the current cluster to the kubellm control plane in Pull mode with a bootstrap token.
+
+It runs inside the member cluster, creates the Cluster object with the bootstrap token, and exchanges the token
+for a client certificate which can only access the Cluster itself. The resulting kubeconfig is stored in the
+Secret ` + memberNamespace + `/` + agentKubeconfigSecretName + ` of the member cluster for kubellm-agent.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.ClusterName = args[0]
+			if errs := opts.Validate(); len(errs) != 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			if err := opts.Run(c.Context()); err != nil {
+				return err
+			}
+			fmt.Fprintf(c.OutOrStdout(), "cluster %q registered, the kubeconfig of kubellm-agent is stored in secret %s/%s\n",
+				opts.ClusterName, memberNamespace, agentKubeconfigSecretName)
+			return nil
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// AddFlags 将 register 的参数注册为命令行参数
+func (o *RegisterOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ControlPlaneServer, "control-plane-server", o.ControlPlaneServer, "Address of the kubellm control plane.")
+	fs.StringVar(&o.ControlPlaneCAFile, "control-plane-ca-file", o.ControlPlaneCAFile,
+		"Path to the CA file used to verify the serving certificate of the kubellm control plane.")
+	fs.StringVar(&o.Token, "token", o.Token, "Bootstrap token created by kubellmctl token create.")
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "Path to the kubeconfig file of the member cluster. Uses in-cluster config if empty.")
+	fs.StringVar(&o.ClusterProvider, "cluster-provider", o.ClusterProvider, "Cloud provider name of the member cluster.")
+	fs.StringVar(&o.ClusterRegion, "cluster-region", o.ClusterRegion, "Region in which the member cluster is located.")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait for the certificate signing request to be approved and signed.")
+}
+
+// Validate 校验 register 的参数
+func (o *RegisterOptions) Validate() []error {
+	var errs []error
+	if msgs := path.ValidatePathSegmentName(o.ClusterName, false); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid cluster name %q: %v", o.ClusterName, msgs))
+	}
+	if len(o.ControlPlaneServer) == 0 {
+		errs = append(errs, fmt.Errorf("--control-plane-server must be set"))
+	}
+	if len(o.ControlPlaneCAFile) == 0 {
+		errs = append(errs, fmt.Errorf("--control-plane-ca-file must be set"))
+	}
+	if _, _, ok := strings.Cut(o.Token, "."); !ok {
+		errs = append(errs, fmt.Errorf("--token must be in the form of <token-id>.<token-secret>"))
+	}
+	if o.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("--timeout must be greater than 0"))
+	}
+	return errs
+}
+
+// Run 注册成员集群。Cluster 已由同一个 bootstrap token 创建时会继续申请证书，因此中途失败后可以重新运行。
+func (o *RegisterOptions) Run(ctx context.Context) error {
+	caData, err := os.ReadFile(o.ControlPlaneCAFile)
+	if err != nil {
+		return fmt.Errorf("failed to read control plane CA: %w", err)
+	}
+	bootstrapConfig := &rest.Config{
+		Host:            o.ControlPlaneServer,
+		BearerToken:     o.Token,
+		TLSClientConfig: rest.TLSClientConfig{CAData: caData},
+	}
+	bootstrapKubeClient, err := kubernetes.NewForConfig(bootstrapConfig)
+	if err != nil {
+		return err
+	}
+	bootstrapKubellmClient, err := versioned.NewForConfig(bootstrapConfig)
+	if err != nil {
+		return err
+	}
+
+	memberConfig, err := clientcmd.BuildConfigFromFlags("", o.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to build member cluster kubeconfig: %w", err)
+	}
+	memberClient, err := kubernetes.NewForConfig(memberConfig)
+	if err != nil {
+		return err
+	}
+
+	if err := o.ensureCluster(ctx, bootstrapKubellmClient, memberClient); err != nil {
+		return err
+	}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	subject := &pkix.Name{CommonName: registration.ClusterUserName(o.ClusterName), Organization: []string{registration.ClusterGroup}}
+	csrData, err := certutil.MakeCSR(privateKey, subject, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate signing request: %w", err)
+	}
+	usages := []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageClientAuth}
+	reqName, reqUID, err := csr.RequestCertificateWithContext(ctx, bootstrapKubeClient, csrData, "", certificatesv1.KubeAPIServerClientSignerName, nil, usages, privateKey)
+	if err != nil {
+		return err
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+	certData, err := csr.WaitForCertificate(waitCtx, bootstrapKubeClient, reqName, reqUID)
+	if err != nil {
+		return fmt.Errorf("failed to wait for certificate signing request %s: %w", reqName, err)
+	}
+	keyData, err := keyutil.MarshalPrivateKeyToPEM(privateKey)
+	if err != nil {
+		return err
+	}
+
+	kubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"kubellm": {Server: o.ControlPlaneServer, CertificateAuthorityData: caData},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			o.ClusterName: {ClientCertificateData: certData, ClientKeyData: keyData},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"kubellm": {Cluster: "kubellm", AuthInfo: o.ClusterName},
+		},
+		CurrentContext: "kubellm",
+	})
+	if err != nil {
+		return err
+	}
+	return saveAgentKubeconfig(ctx, memberClient, kubeconfig)
+}
+
+// ensureCluster 使用 bootstrap token 以 Pull 模式创建 Cluster，并记录创建它的 bootstrap token 用户。
+// Cluster 已存在时，要求它由同一个 bootstrap token 创建并且 ID 与当前成员集群一致。
+func (o *RegisterOptions) ensureCluster(ctx context.Context, kubellmClient versioned.Interface, memberClient kubernetes.Interface) error {
+	id, err := membercluster.GetClusterID(ctx, memberClient)
+	if err != nil {
+		return err
+	}
+	tokenID, _, _ := strings.Cut(o.Token, ".")
+	bootstrapUser := bootstrapUserPrefix + tokenID
+
+	cluster := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        o.ClusterName,
+			Annotations: map[string]string{registration.BootstrapUserAnnotation: bootstrapUser},
+		},
+		Spec: clusterv1alpha1.ClusterSpec{
+			ID:       id,
+			SyncMode: clusterv1alpha1.Pull,
+			Provider: o.ClusterProvider,
+			Region:   o.ClusterRegion,
+		},
+	}
+	_, err = kubellmClient.ClusterV1alpha1().Clusters().Create(ctx, cluster, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create cluster %s: %w", o.ClusterName, err)
+	}
+
+	// bootstrap token 只能读取自己创建的 Cluster
+	existing, err := kubellmClient.ClusterV1alpha1().Clusters().Get(ctx, o.ClusterName, metav1.GetOptions{})
+	if apierrors.IsForbidden(err) {
+		return fmt.Errorf("cluster %s is already registered by another cluster", o.ClusterName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get cluster %s: %w", o.ClusterName, err)
+	}
+	if existing.Annotations[registration.BootstrapUserAnnotation] != bootstrapUser || existing.Spec.ID != id {
+		return fmt.Errorf("cluster %s is already registered by another cluster", o.ClusterName)
+	}
+	return nil
+}
+
+// saveAgentKubeconfig 把 kubellm-agent 使用的 kubeconfig 保存到成员集群的 Secret 中
+func saveAgentKubeconfig(ctx context.Context, client kubernetes.Interface, kubeconfig []byte) error {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: memberNamespace}}
+	if _, err := client.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %w", memberNamespace, err)
+	}
+
+	secrets := client.CoreV1().Secrets(memberNamespace)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: memberNamespace, Name: agentKubeconfigSecretName},
+		Data:       map[string][]byte{agentKubeconfigKey: kubeconfig},
+	}
+	_, err := secrets.Create(ctx, secret, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		var existing *corev1.Secret
+		if existing, err = secrets.Get(ctx, agentKubeconfigSecretName, metav1.GetOptions{}); err == nil {
+			existing.Data = secret.Data
+			_, err = secrets.Update(ctx, existing, metav1.UpdateOptions{})
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save secret %s/%s: %w", memberNamespace, agentKubeconfigSecretName, err)
+	}
+	return nil
+}
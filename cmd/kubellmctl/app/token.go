@@ -0,0 +1,215 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/registration"
+)
+
+// bootstrap token Secret 的格式，参见 k8s.io/cluster-bootstrap/token/api
+const (
+	bootstrapTokenSecretPrefix  = "bootstrap-token-"
+	bootstrapTokenIDKey         = "token-id"
+	bootstrapTokenSecretKey     = "token-secret"
+	bootstrapTokenExpirationKey = "expiration"
+	bootstrapTokenDescription   = "description"
+	bootstrapTokenUsageAuthKey  = "usage-bootstrap-authentication"
+	bootstrapTokenExtraGroups   = "auth-extra-groups"
+	// bootstrapUserPrefix 是 bootstrap token 认证后的用户名前缀，后接 token-id
+	bootstrapUserPrefix = "system:bootstrap:"
+
+	bootstrapTokenIDLength     = 6
+	bootstrapTokenSecretLength = 16
+	bootstrapTokenCharset      = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	// bootstrapperRoleName 是 bootstrap token 在控制面中使用的 ClusterRole 与 ClusterRoleBinding 名称
+	bootstrapperRoleName = "kubellm:cluster-bootstrapper"
+)
+
+// bootstrapperRules 是 bootstrap token 的权限：提交并等待 CSR，创建 Cluster 以及读取自己创建的 Cluster。
+// RBAC 无法按创建者限制 get，kubellm-apiserver 只允许 bootstrap token 读取自己创建的 Cluster，
+// 并且只允许创建不带连接信息的 Pull 模式集群。
+var bootstrapperRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{certificatesv1.GroupName},
+		Resources: []string{"certificatesigningrequests"},
+		Verbs:     []string{"create", "get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{clusterv1alpha1.SchemeGroupVersion.Group},
+		Resources: []string{clusterv1alpha1.ResourcePluralCluster},
+		Verbs:     []string{"create", "get"},
+	},
+}
+
+// NewTokenCommand 创建 token 命令
+func NewTokenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage bootstrap tokens used by kubellmctl register",
+		RunE: func(c *cobra.Command, args []string) error {
+			return c.Help()
+		},
+	}
+	cmd.AddCommand(NewTokenCreateCommand())
+	return cmd
+}
+
+// TokenCreateOptions 是 token create 命令的参数
+type TokenCreateOptions struct {
+	// Kubeconfig 与 Context 指定访问 kubellm 控制面的 kubeconfig
+	Kubeconfig string
+	Context    string
+
+	// TTL 是 token 的有效期
+	TTL time.Duration
+	// Description 会写入 bootstrap token Secret，便于管理员识别 token 的用途
+	Description string
+}
+
+// NewTokenCreateCommand 创建 token create 命令
+func NewTokenCreateCommand() *cobra.Command {
+	opts := &TokenCreateOptions{TTL: time.Hour}
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a short-lived bootstrap token for registering member clusters",
+		Long: `Create a short-lived bootstrap token for registering member clusters and print it.
+
+The token can only submit certificate signing requests and create Cluster objects. It requires bootstrap token
+authentication to be enabled on the kube-apiserver of the control plane (--enable-bootstrap-token-auth).`,
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			if errs := opts.Validate(); len(errs) != 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			token, err := opts.Run(c.Context())
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(c.OutOrStdout(), token)
+			return nil
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// AddFlags 将 token create 的参数注册为命令行参数
+func (o *TokenCreateOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "Path to the kubeconfig file of the kubellm control plane.")
+	fs.StringVar(&o.Context, "context", o.Context, "Name of the kubeconfig context to use for the kubellm control plane.")
+	fs.DurationVar(&o.TTL, "ttl", o.TTL, "The duration before the token is automatically deleted.")
+	fs.StringVar(&o.Description, "description", o.Description, "A human friendly description of how this token is used.")
+}
+
+// Validate 校验 token create 的参数
+func (o *TokenCreateOptions) Validate() []error {
+	var errs []error
+	if o.TTL <= 0 {
+		errs = append(errs, fmt.Errorf("--ttl must be greater than 0"))
+	}
+	return errs
+}
+
+// Run 创建 bootstrap token 并返回 token 字符串
+func (o *TokenCreateOptions) Run(ctx context.Context) (string, error) {
+	config, err := buildConfig(o.Kubeconfig, o.Context)
+	if err != nil {
+		return "", fmt.Errorf("failed to build control plane kubeconfig: %w", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return "", err
+	}
+
+	if err := ensureBootstrapperRBAC(ctx, kubeClient); err != nil {
+		return "", err
+	}
+
+	tokenID, err := randomString(bootstrapTokenIDLength)
+	if err != nil {
+		return "", err
+	}
+	tokenSecret, err := randomString(bootstrapTokenSecretLength)
+	if err != nil {
+		return "", err
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceSystem,
+			Name:      bootstrapTokenSecretPrefix + tokenID,
+		},
+		Type: corev1.SecretTypeBootstrapToken,
+		StringData: map[string]string{
+			bootstrapTokenIDKey:         tokenID,
+			bootstrapTokenSecretKey:     tokenSecret,
+			bootstrapTokenExpirationKey: time.Now().Add(o.TTL).UTC().Format(time.RFC3339),
+			bootstrapTokenUsageAuthKey:  "true",
+			bootstrapTokenExtraGroups:   registration.BootstrapGroup,
+		},
+	}
+	if len(o.Description) > 0 {
+		secret.StringData[bootstrapTokenDescription] = o.Description
+	}
+	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return "", fmt.Errorf("failed to create bootstrap token: %w", err)
+	}
+	return tokenID + "." + tokenSecret, nil
+}
+
+// ensureBootstrapperRBAC 创建或更新 bootstrap token 所属组的 ClusterRole 与 ClusterRoleBinding
+func ensureBootstrapperRBAC(ctx context.Context, client kubernetes.Interface) error {
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: bootstrapperRoleName}, Rules: bootstrapperRules}
+	_, err := client.RbacV1().ClusterRoles().Create(ctx, clusterRole, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		// 升级 kubellm 后规则可能变化，这里以当前版本为准
+		var existing *rbacv1.ClusterRole
+		if existing, err = client.RbacV1().ClusterRoles().Get(ctx, bootstrapperRoleName, metav1.GetOptions{}); err == nil {
+			existing.Rules = bootstrapperRules
+			_, err = client.RbacV1().ClusterRoles().Update(ctx, existing, metav1.UpdateOptions{})
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to ensure cluster role %s: %w", bootstrapperRoleName, err)
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: bootstrapperRoleName},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: bootstrapperRoleName},
+		Subjects:   []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: registration.BootstrapGroup}},
+	}
+	if _, err := client.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create cluster role binding %s: %w", bootstrapperRoleName, err)
+	}
+	return nil
+}
+
+// randomString 使用 crypto/rand 生成由 bootstrapTokenCharset 中字符组成的字符串
+func randomString(length int) (string, error) {
+	max := big.NewInt(int64(len(bootstrapTokenCharset)))
+	b := make([]byte, length)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = bootstrapTokenCharset[n.Int64()]
+	}
+	return string(b), nil
+}
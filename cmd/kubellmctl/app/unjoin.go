@@ -0,0 +1,164 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/impersonation"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+)
+
+// UnjoinOptions 是 unjoin 命令的参数
+type UnjoinOptions struct {
+	// Kubeconfig 与 Context 指定访问 kubellm 控制面的 kubeconfig
+	Kubeconfig string
+	Context    string
+
+	// ClusterName 是要移除的 Cluster 名称
+	ClusterName string
+	// ClusterKubeconfig 与 ClusterContext 指定访问成员集群的 kubeconfig，为空时不清理成员集群中的对象
+	ClusterKubeconfig string
+	ClusterContext    string
+	// ClusterNamespace 是 Cluster 已被删除时查找凭证 Secret 的命名空间
+	ClusterNamespace string
+}
+
+// NewUnjoinCommand 创建 unjoin 命令
+func NewUnjoinCommand() *cobra.Command {
+	opts := &UnjoinOptions{ClusterNamespace: defaultClusterNamespace}
+	cmd := &cobra.Command{
+		Use:   "unjoin <name> [--cluster-kubeconfig=<path>]",
+		Short: "Remove a member cluster registered by join from the kubellm control plane",
+		Long: `Remove a member cluster registered by join from the kubellm control plane.
+
+It deletes the Cluster object and its credential Secret. If --cluster-kubeconfig is set, it also deletes the
+namespace, ServiceAccount and RBAC objects created in the member cluster by join and by the kubellm controllers.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.ClusterName = args[0]
+			if errs := opts.Validate(); len(errs) != 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			if err := opts.Run(c.Context()); err != nil {
+				return err
+			}
+			if len(opts.ClusterKubeconfig) == 0 {
+				fmt.Fprintf(c.OutOrStdout(), "cluster %q unjoined, objects in the member cluster are left since --cluster-kubeconfig is not set\n", opts.ClusterName)
+				return nil
+			}
+			fmt.Fprintf(c.OutOrStdout(), "cluster %q unjoined\n", opts.ClusterName)
+			return nil
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+// AddFlags 将 unjoin 的参数注册为命令行参数
+func (o *UnjoinOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "Path to the kubeconfig file of the kubellm control plane.")
+	fs.StringVar(&o.Context, "context", o.Context, "Name of the kubeconfig context to use for the kubellm control plane.")
+	fs.StringVar(&o.ClusterKubeconfig, "cluster-kubeconfig", o.ClusterKubeconfig,
+		"Path to the kubeconfig file of the member cluster. Objects in the member cluster are left if empty.")
+	fs.StringVar(&o.ClusterContext, "cluster-context", o.ClusterContext, "Name of the kubeconfig context to use for the member cluster.")
+	fs.StringVar(&o.ClusterNamespace, "cluster-namespace", o.ClusterNamespace,
+		"Namespace of the control plane in which the credentials of the member cluster are stored. Only used if the Cluster is already deleted.")
+}
+
+// Validate 校验 unjoin 的参数
+func (o *UnjoinOptions) Validate() []error {
+	var errs []error
+	if msgs := path.ValidatePathSegmentName(o.ClusterName, false); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid cluster name %q: %v", o.ClusterName, msgs))
+	}
+	if len(o.ClusterNamespace) == 0 {
+		errs = append(errs, fmt.Errorf("--cluster-namespace must be set"))
+	}
+	return errs
+}
+
+// Run 按与 join 相反的顺序移除成员集群：先删除 Cluster 使控制器停止访问成员集群，再删除凭证，最后清理成员集群中的对象。
+// 已不存在的对象会被跳过，因此中途失败后可以重新运行。
+func (o *UnjoinOptions) Run(ctx context.Context) error {
+	controlPlaneConfig, err := buildConfig(o.Kubeconfig, o.Context)
+	if err != nil {
+		return fmt.Errorf("failed to build control plane kubeconfig: %w", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(controlPlaneConfig)
+	if err != nil {
+		return err
+	}
+	kubellmClient, err := versioned.NewForConfig(controlPlaneConfig)
+	if err != nil {
+		return err
+	}
+
+	secretRef := &clusterv1alpha1.LocalSecretReference{Namespace: o.ClusterNamespace, Name: o.ClusterName}
+	cluster, err := kubellmClient.ClusterV1alpha1().Clusters().Get(ctx, o.ClusterName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed to get cluster %s: %w", o.ClusterName, err)
+	default:
+		// Pull 模式的集群由 agent 注册，成员集群中的命名空间可能正被 agent 使用
+		if cluster.Spec.SyncMode == clusterv1alpha1.Pull {
+			return fmt.Errorf("cluster %s is registered by kubellm-agent in %s mode, it cannot be unjoined", o.ClusterName, clusterv1alpha1.Pull)
+		}
+		if cluster.Spec.SecretRef != nil {
+			secretRef = cluster.Spec.SecretRef
+		}
+		err := kubellmClient.ClusterV1alpha1().Clusters().Delete(ctx, o.ClusterName, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete cluster %s: %w", o.ClusterName, err)
+		}
+	}
+
+	err = kubeClient.CoreV1().Secrets(secretRef.Namespace).Delete(ctx, secretRef.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+
+	if len(o.ClusterKubeconfig) == 0 {
+		return nil
+	}
+	memberConfig, err := buildConfig(o.ClusterKubeconfig, o.ClusterContext)
+	if err != nil {
+		return fmt.Errorf("failed to build member cluster kubeconfig: %w", err)
+	}
+	memberClient, err := kubernetes.NewForConfig(memberConfig)
+	if err != nil {
+		return err
+	}
+	return deleteMemberObjects(ctx, memberClient)
+}
+
+// deleteMemberObjects 删除 join 以及 impersonation 控制器在成员集群中创建的对象，
+// ServiceAccount 与 token Secret 随命名空间一起删除
+func deleteMemberObjects(ctx context.Context, client kubernetes.Interface) error {
+	for _, name := range []string{memberServiceAccountName, impersonation.ImpersonatorName} {
+		err := client.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete cluster role binding %s: %w", name, err)
+		}
+		err = client.RbacV1().ClusterRoles().Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete cluster role %s: %w", name, err)
+		}
+	}
+
+	err := client.CoreV1().Namespaces().Delete(ctx, memberNamespace, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete namespace %s: %w", memberNamespace, err)
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ CODE_GENERATOR_VERSION="${CODE_GENERATOR_VERSION:-v0.32.3}"
 CONTROLLER_TOOLS_VERSION="${CONTROLLER_TOOLS_VERSION:-latest}"
 KUBE_OPENAPI_VERSION="${KUBE_OPENAPI_VERSION:-latest}"
 PROTOBUF_VERSION="${PROTOBUF_VERSION:-latest}"  
+GRPC_GEN_VERSION="${GRPC_GEN_VERSION:-v1.5.1}"
 
 # 安装代码生成工具
 install_tools() {
@@ -86,6 +87,10 @@ install_tools() {
   kubellm::log::info "- 安装 protoc-gen-go..."
   GOBIN="${GOBIN:-${GOPATH}/bin}" go install google.golang.org/protobuf/cmd/protoc-gen-go@${PROTOBUF_VERSION}
 
+  kubellm::log::info "安装 google.golang.org/grpc/cmd/protoc-gen-go-grpc 工具 (${GRPC_GEN_VERSION})..."
+  kubellm::log::info "- 安装 protoc-gen-go-grpc..."
+  GOBIN="${GOBIN:-${GOPATH}/bin}" go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@${GRPC_GEN_VERSION}
+
   kubellm::log::info "安装 k8s.io/code-generator/cmd/go-to-protobuf/protoc-gen-gogo 工具 (${CODE_GENERATOR_VERSION})..."
   kubellm::log::info "- 安装 protoc-gen-gogo..."
   GOBIN="${GOBIN:-${GOPATH}/bin}" go install k8s.io/code-generator/cmd/go-to-protobuf/protoc-gen-gogo@${CODE_GENERATOR_VERSION}
@@ -135,6 +140,7 @@ check_tools() {
     "openapi-gen"
     "controller-gen"
     "protoc-gen-go"
+    "protoc-gen-go-grpc"
     "protoc-gen-gogo"
   )
   
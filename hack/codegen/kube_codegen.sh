@@ -748,32 +748,21 @@ kube::codegen::gen_informer() {
   # informer-gen会在此目录下按 group/version 创建子目录
   mkdir -p "${CLIENT_OUTPUT_DIR}/informers"
   
-  # 从API包提取组和版本信息
-  for input_pkg in "${FOUND_API_PKGS[@]}"; do
-    # 从包路径提取组和版本信息
-    # 例如：github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1
-    IFS='/' read -ra parts <<< "${input_pkg}"
-    local group_idx=$((${#parts[@]} - 2))
-    local version_idx=$((${#parts[@]} - 1))
-    local group="${parts[group_idx]}"
-    local version="${parts[version_idx]}"
-    
-    # 构建参数
-    local informer_args=()
-    informer_args+=("--go-header-file=${BOILERPLATE}")
-    informer_args+=("--versioned-clientset-package=${CLIENT_PKG}/clientset/versioned")
-    # listers-package 需要指向特定 group/version 的 lister 包路径
-    # 假设 lister 已按 group/version 正确生成在 ${CLIENT_PKG}/listers/${group}/${version}
-    informer_args+=("--listers-package=${CLIENT_PKG}/listers")
-    # output-pkg 指向Informer的根包名
-    informer_args+=("--output-pkg=${CLIENT_PKG}/informers")
-    # output-dir 指向Informer的根目录
-    informer_args+=("--output-dir=${CLIENT_OUTPUT_DIR}/informers")
-    
-    kube::log::info "为API包 ${input_pkg} 生成 Informer (预期输出到 ${CLIENT_PKG}/informers/${group}/${version})"
-    kube::log::info "执行: ${INFORMER_GEN} ${informer_args[*]} ${input_pkg}"
-    ${INFORMER_GEN} "${informer_args[@]}" "${input_pkg}"
-  done
+  # 所有 API 包必须在一次 informer-gen 调用中生成，
+  # 否则 factory.go 与 generic.go 会被最后一个包覆盖，只包含最后一个组
+  local informer_args=()
+  informer_args+=("--go-header-file=${BOILERPLATE}")
+  informer_args+=("--versioned-clientset-package=${CLIENT_PKG}/clientset/versioned")
+  # listers-package 指向 lister 的根包，informer-gen 会按 group/version 拼接子路径
+  informer_args+=("--listers-package=${CLIENT_PKG}/listers")
+  # output-pkg 指向Informer的根包名
+  informer_args+=("--output-pkg=${CLIENT_PKG}/informers")
+  # output-dir 指向Informer的根目录
+  informer_args+=("--output-dir=${CLIENT_OUTPUT_DIR}/informers")
+
+  kube::log::info "为API包 ${FOUND_API_PKGS[*]} 生成 Informer"
+  kube::log::info "执行: ${INFORMER_GEN} ${informer_args[*]} ${FOUND_API_PKGS[*]}"
+  ${INFORMER_GEN} "${informer_args[@]}" "${FOUND_API_PKGS[@]}"
   
   kube::log::status "Informer代码生成完成"
 }
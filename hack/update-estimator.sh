@@ -0,0 +1,51 @@
+#!/usr/bin/env bash
+
+# Copyright 2025 The Kubellm Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# 生成 estimator 的 gRPC 代码，需要 protoc、protoc-gen-go 与 protoc-gen-go-grpc
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+CURRENT_PROJECT_ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd -P)"
+# shellcheck source=./lib/init.sh
+source "${CURRENT_PROJECT_ROOT}/lib/init.sh"
+
+ESTIMATOR_PB_DIR="${PROJECT_ROOT}/pkg/estimator/pb"
+
+# 优先使用项目 bin 目录中的 protoc，与 kube::codegen::gen_protobuf 保持一致
+PROTOC="${PROJECT_ROOT}/bin/protoc"
+if [[ ! -x "${PROTOC}" ]]; then
+  PROTOC=$(command -v protoc 2>/dev/null || true)
+fi
+if [[ -z "${PROTOC}" ]]; then
+  kubellm::log::fatal "未找到 protoc 命令，请将其安装到系统 PATH 或项目的 bin/protoc"
+fi
+
+GOBIN="${GOBIN:-$(go env GOPATH)/bin}"
+for plugin in protoc-gen-go protoc-gen-go-grpc; do
+  if ! command -v "${plugin}" &>/dev/null && [[ ! -x "${GOBIN}/${plugin}" ]]; then
+    kubellm::log::fatal "未找到 ${plugin}，请运行 hack/codegen/install-tools.sh 安装"
+  fi
+done
+
+kubellm::log::info "生成 estimator gRPC 代码..."
+PATH="${GOBIN}:${PATH}" "${PROTOC}" \
+  -I "${ESTIMATOR_PB_DIR}" \
+  --go_out="${ESTIMATOR_PB_DIR}" --go_opt=paths=source_relative \
+  --go-grpc_out="${ESTIMATOR_PB_DIR}" --go-grpc_opt=paths=source_relative \
+  "${ESTIMATOR_PB_DIR}/estimator.proto"
+kubellm::log::info "✓ estimator gRPC 代码生成成功"
@@ -17,6 +17,40 @@ const (
 	ResourceNamespaceScopedCluster = false
 
 	ClusterConditionReady = "Ready"
+	// ClusterConditionDuplicateID means another Cluster object has the same spec.id,
+	// that is, they point at the same physical cluster.
+	ClusterConditionDuplicateID = "DuplicateID"
+)
+
+const (
+	// TaintClusterNotReady will be added to a cluster when its Ready condition is False,
+	// and removed when the cluster becomes ready.
+	TaintClusterNotReady = "cluster.kubellm.io/not-ready"
+	// TaintClusterUnreachable will be added to a cluster when its Ready condition is Unknown,
+	// and removed when the cluster becomes reachable and ready.
+	TaintClusterUnreachable = "cluster.kubellm.io/unreachable"
+)
+
+const (
+	// ClusterFieldID is the field selector path of spec.id.
+	ClusterFieldID = "spec.id"
+	// ClusterFieldProvider is the field selector path of spec.provider.
+	ClusterFieldProvider = "spec.provider"
+	// ClusterFieldRegion is the field selector path of spec.region.
+	ClusterFieldRegion = "spec.region"
+	// ClusterFieldReady is the field selector path of the computed ready state of a cluster,
+	// its value is "true" if the Ready condition is True, otherwise "false".
+	ClusterFieldReady = "status.ready"
+)
+
+const (
+	// ResourceNvidiaGPU is the extended resource name of NVIDIA GPUs.
+	ResourceNvidiaGPU corev1.ResourceName = "nvidia.com/gpu"
+	// ResourceNvidiaMIGPrefix is the prefix of the extended resource names of NVIDIA MIG devices
+	// advertised with the mixed MIG strategy, e.g. nvidia.com/mig-1g.10gb.
+	ResourceNvidiaMIGPrefix = "nvidia.com/mig-"
+	// ResourceAMDGPU is the extended resource name of AMD GPUs.
+	ResourceAMDGPU corev1.ResourceName = "amd.com/gpu"
 )
 
 const (
@@ -28,6 +62,15 @@ const (
 
 // +kubebuilder:subresource:status
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.kubernetesVersion",description="成员集群的 Kubernetes 版本"
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.syncMode",description="成员集群的同步模式"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="成员集群是否就绪"
+// +kubebuilder:printcolumn:name="ReadyNodes",type="integer",JSONPath=".status.nodeSummary.readyNum",description="成员集群中就绪的节点数"
+// +kubebuilder:printcolumn:name="TotalNodes",type="integer",JSONPath=".status.nodeSummary.totalNum",description="成员集群中的节点总数"
+// +kubebuilder:printcolumn:name="GPU",type="string",JSONPath=`.status.resourceSummary.allocatable.nvidia\.com/gpu`,description="成员集群中可分配的 GPU 数量"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.region",description="成员集群所在的地域"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen=true
 // +k8s:openapi-gen=true
@@ -77,9 +120,10 @@ type ClusterSpec struct {
 	DisplayName string `json:"displayName,omitempty"`
 
 	// SyncMode describes how a cluster syncs resources from kubellm control plane.
+	// Defaults to Push.
 	// +kubebuilder:validation:Enum=Push;Pull
 	// +required
-	// SyncMode ClusterSyncMode `json:"syncMode"`
+	SyncMode ClusterSyncMode `json:"syncMode"`
 
 	// The API endpoint of the member cluster. This can be a hostname,
 	// hostname:port, IP or IP:port.
@@ -132,8 +176,11 @@ type ClusterSpec struct {
 	Taints []corev1.Taint `json:"taints,omitempty"`
 
 	// ResourceModels is the list of resource modeling in this cluster. Each modeling quota can be customized by the user.
-	// Modeling name must be one of the following: cpu, memory, storage, ephemeral-storage.
+	// Modeling name must be one of the following: cpu, memory, storage, ephemeral-storage,
+	// or a GPU extended resource name, e.g. nvidia.com/gpu, amd.com/gpu or nvidia.com/mig-1g.10gb.
 	// If the user does not define the modeling name and modeling quota, it will be the default model.
+	// Resource models are only used by the coarse estimate the scheduler falls back to when the estimator
+	// of the cluster is unavailable. The estimator computes replicas from the free resources of each node and ignores them.
 	// The default model grade from 0 to 8.
 	// When grade = 0 or grade = 1, the default model's cpu quota and memory quota is a fix value.
 	// When grade greater than or equal to 2, each default model's cpu quota is [2^(grade-1), 2^grade), 2 <= grade <= 7
@@ -190,6 +237,21 @@ type ClusterSpec struct {
 	ResourceModels []ResourceModel `json:"resourceModels,omitempty"`
 }
 
+// ClusterSyncMode describes the mode of synchronization between member cluster and kubellm control plane.
+type ClusterSyncMode string
+
+const (
+	// Push means that the controller on the kubellm control plane will be in charge of synchronization.
+	// The controller watches resources change on kubellm control plane and then pushes them to member cluster.
+	Push ClusterSyncMode = "Push"
+
+	// Pull means that the controller running on the member cluster will be in charge of synchronization.
+	// The controller, as well known as 'kubellm-agent', watches resources change on kubellm control plane,
+	// then fetches them and applies locally on the member cluster. It also reports the cluster status and
+	// keeps a reverse tunnel to the control plane, so the member cluster needs no inbound connectivity.
+	Pull ClusterSyncMode = "Pull"
+)
+
 // LocalSecretReference is a reference to a secret within the enclosing
 // namespace.
 type LocalSecretReference struct {
@@ -258,6 +320,12 @@ type ClusterStatus struct {
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// LastHeartbeatTime is the last time the kubellm-agent of a cluster in Pull mode reported the status.
+	// The control plane cannot probe such clusters, so it sets the Ready condition to Unknown
+	// once the agent stops reporting for longer than the grace period.
+	// +optional
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+
 	// NodeSummary represents the summary of nodes status in the member cluster.
 	// +optional
 	NodeSummary *NodeSummary `json:"nodeSummary,omitempty"`
@@ -266,6 +334,12 @@ type ClusterStatus struct {
 	// +optional
 	ResourceSummary *ResourceSummary `json:"resourceSummary,omitempty"`
 
+	// GPUInventory represents the GPUs in the member cluster, grouped by resource name, product,
+	// memory and MIG profile. It is built from the node labels published by the GPU feature discovery
+	// and the allocatable GPU extended resources of the nodes.
+	// +optional
+	GPUInventory []GPUSummary `json:"gpuInventory,omitempty"`
+
 	// RemedyActions represents the remedy actions that needs to be performed
 	// on the cluster.
 	// +optional
@@ -328,6 +402,45 @@ type ResourceSummary struct {
 	AllocatableModelings []AllocatableModeling `json:"allocatableModelings,omitempty"`
 }
 
+// +k8s:deepcopy-gen=true
+// GPUSummary represents the GPUs of the same model in the member cluster.
+type GPUSummary struct {
+	// ResourceName is the extended resource name that pods request the GPUs with,
+	// e.g. nvidia.com/gpu or nvidia.com/mig-1g.10gb.
+	// +required
+	ResourceName corev1.ResourceName `json:"resourceName"`
+
+	// Product is the product name of the GPUs, e.g. NVIDIA-A100-SXM4-80GB.
+	// It is empty if the nodes are not labeled with the product.
+	// +optional
+	Product string `json:"product,omitempty"`
+
+	// Memory is the memory of each GPU, or of each MIG device for a MIG profile.
+	// It is empty if the nodes are not labeled with the memory.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// MIGProfile is the MIG profile of the devices, e.g. 1g.10gb. It is empty for full GPUs.
+	// +optional
+	MIGProfile string `json:"migProfile,omitempty"`
+
+	// NodeCount is the number of nodes that own the GPUs.
+	// +required
+	NodeCount int32 `json:"nodeCount"`
+
+	// Allocatable is the number of GPUs that are available for scheduling on all nodes.
+	// +required
+	Allocatable int64 `json:"allocatable"`
+
+	// Allocated is the number of GPUs requested by the pods that have been scheduled to the nodes.
+	// +required
+	Allocated int64 `json:"allocated"`
+
+	// Free is the number of GPUs that are not requested by any pod, that is, Allocatable minus Allocated.
+	// +required
+	Free int64 `json:"free"`
+}
+
 // +k8s:deepcopy-gen=true
 // AllocatableModeling represents the number of nodes in which allocatable resources in a specific resource model grade.
 // E.g. AllocatableModeling{Grade: 2, Count: 10} means 10 nodes belong to resource model in grade 2.
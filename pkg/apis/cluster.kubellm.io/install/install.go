@@ -0,0 +1,18 @@
+package install
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// Install 将 cluster.kubellm.io 的内部版本与所有外部版本注册到给定的 scheme 中，
+// 并设置版本优先级，供 API Server 进行编解码与版本转换。
+func Install(scheme *runtime.Scheme) {
+	utilruntime.Must(clusterkubellmio.Install(scheme))
+	utilruntime.Must(v1alpha1.Install(scheme))
+	utilruntime.Must(v1alpha1.RegisterDefaults(scheme))
+	utilruntime.Must(scheme.SetVersionPriority(v1alpha1.SchemeGroupVersion))
+}
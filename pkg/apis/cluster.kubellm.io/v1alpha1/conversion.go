@@ -0,0 +1,28 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(addConversionFuncs)
+}
+
+// addConversionFuncs 注册 Cluster 支持的字段选择器，未注册的字段在 List/Watch 时会被拒绝
+func addConversionFuncs(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind(ResourceKindCluster),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "metadata.name",
+				ClusterFieldID,
+				ClusterFieldProvider,
+				ClusterFieldRegion,
+				ClusterFieldReady:
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// SetDefaults_ClusterSpec 在未设置 SyncMode 时使用 Push 模式，在未设置 ResourceModels 时填充默认的资源模型（grade 0 到 8）
+func SetDefaults_ClusterSpec(obj *ClusterSpec) {
+	if len(obj.SyncMode) == 0 {
+		obj.SyncMode = Push
+	}
+	if len(obj.ResourceModels) == 0 {
+		obj.ResourceModels = DefaultResourceModels()
+	}
+}
+
+// DefaultResourceModels 返回 ClusterSpec.ResourceModels 注释中描述的默认资源模型：
+//   - grade 0: cpu [0, 1), memory [0, 4Gi)
+//   - grade 1: cpu [1, 2), memory [4Gi, 16Gi)
+//   - grade 2 到 7: cpu [2^(grade-1), 2^grade), memory [2^(grade+2)Gi, 2^(grade+3)Gi)
+//   - grade 8: cpu [128, +∞), memory [1024Gi, +∞)
+func DefaultResourceModels() []ResourceModel {
+	models := []ResourceModel{
+		newResourceModel(0, "0", "1", "0", "4Gi"),
+		newResourceModel(1, "1", "2", "4Gi", "16Gi"),
+	}
+	for grade := uint(2); grade <= 7; grade++ {
+		models = append(models, newResourceModel(grade,
+			fmt.Sprint(1<<(grade-1)), fmt.Sprint(1<<grade),
+			fmt.Sprintf("%dGi", 1<<(grade+2)), fmt.Sprintf("%dGi", 1<<(grade+3))))
+	}
+	// 最后一个等级的 Max 表示无穷大
+	infinite := resource.NewQuantity(math.MaxInt64, resource.DecimalSI).String()
+	return append(models, newResourceModel(8, "128", infinite, "1024Gi", infinite))
+}
+
+func newResourceModel(grade uint, minCPU, maxCPU, minMemory, maxMemory string) ResourceModel {
+	return ResourceModel{
+		Grade: grade,
+		Ranges: []ResourceModelRange{
+			{Name: corev1.ResourceCPU, Min: resource.MustParse(minCPU), Max: resource.MustParse(maxCPU)},
+			{Name: corev1.ResourceMemory, Min: resource.MustParse(minMemory), Max: resource.MustParse(maxMemory)},
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package v1alpha1_test
+
+import (
+	"math"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/install"
+	"github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	return scheme
+}
+
+func TestDefaultResourceModels(t *testing.T) {
+	infinite := resource.NewQuantity(math.MaxInt64, resource.DecimalSI).String()
+	expected := []struct {
+		minCPU, maxCPU, minMemory, maxMemory string
+	}{
+		{"0", "1", "0", "4Gi"},
+		{"1", "2", "4Gi", "16Gi"},
+		{"2", "4", "16Gi", "32Gi"},
+		{"4", "8", "32Gi", "64Gi"},
+		{"8", "16", "64Gi", "128Gi"},
+		{"16", "32", "128Gi", "256Gi"},
+		{"32", "64", "256Gi", "512Gi"},
+		{"64", "128", "512Gi", "1024Gi"},
+		{"128", infinite, "1024Gi", infinite},
+	}
+
+	models := v1alpha1.DefaultResourceModels()
+	if len(models) != len(expected) {
+		t.Fatalf("expected %d resource models, got %d", len(expected), len(models))
+	}
+	for i, want := range expected {
+		model := models[i]
+		if model.Grade != uint(i) {
+			t.Errorf("model %d: expected grade %d, got %d", i, i, model.Grade)
+		}
+		wantRanges := []v1alpha1.ResourceModelRange{
+			{Name: corev1.ResourceCPU, Min: resource.MustParse(want.minCPU), Max: resource.MustParse(want.maxCPU)},
+			{Name: corev1.ResourceMemory, Min: resource.MustParse(want.minMemory), Max: resource.MustParse(want.maxMemory)},
+		}
+		if !apiequality.Semantic.DeepEqual(model.Ranges, wantRanges) {
+			t.Errorf("grade %d: expected ranges %v, got %v", i, wantRanges, model.Ranges)
+		}
+	}
+}
+
+func TestSetDefaultsClusterSpec(t *testing.T) {
+	custom := []v1alpha1.ResourceModel{{
+		Grade: 0,
+		Ranges: []v1alpha1.ResourceModelRange{
+			{Name: corev1.ResourceCPU, Min: resource.MustParse("0"), Max: resource.MustParse("4")},
+		},
+	}}
+	tests := []struct {
+		name         string
+		spec         v1alpha1.ClusterSpec
+		expectedMode v1alpha1.ClusterSyncMode
+		expected     []v1alpha1.ResourceModel
+	}{
+		{
+			name:         "empty spec",
+			spec:         v1alpha1.ClusterSpec{},
+			expectedMode: v1alpha1.Push,
+			expected:     v1alpha1.DefaultResourceModels(),
+		},
+		{
+			name:         "pull mode and custom resource models are kept",
+			spec:         v1alpha1.ClusterSpec{SyncMode: v1alpha1.Pull, ResourceModels: custom},
+			expectedMode: v1alpha1.Pull,
+			expected:     custom,
+		},
+	}
+
+	scheme := newScheme(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &v1alpha1.Cluster{Spec: tt.spec}
+			scheme.Default(cluster)
+			if cluster.Spec.SyncMode != tt.expectedMode {
+				t.Errorf("expected sync mode %q, got %q", tt.expectedMode, cluster.Spec.SyncMode)
+			}
+			if !apiequality.Semantic.DeepEqual(cluster.Spec.ResourceModels, tt.expected) {
+				t.Errorf("expected resource models %v, got %v", tt.expected, cluster.Spec.ResourceModels)
+			}
+		})
+	}
+}
+
+// TestDefaultResourceModelsRoundTrip 确认解码时填充的默认资源模型在内部版本与 v1alpha1 之间转换后保持不变
+func TestDefaultResourceModelsRoundTrip(t *testing.T) {
+	scheme := newScheme(t)
+	codecs := serializer.NewCodecFactory(scheme)
+
+	data := []byte(`{"apiVersion":"cluster.kubellm.io/v1alpha1","kind":"Cluster","metadata":{"name":"member1"},"spec":{}}`)
+	obj, _, err := codecs.UniversalDecoder(clusterkubellmio.SchemeGroupVersion).Decode(data, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to decode cluster: %v", err)
+	}
+	internal, ok := obj.(*clusterkubellmio.Cluster)
+	if !ok {
+		t.Fatalf("expected an internal Cluster, got %T", obj)
+	}
+	if len(internal.Spec.ResourceModels) != 9 {
+		t.Fatalf("expected 9 default resource models after decoding, got %d", len(internal.Spec.ResourceModels))
+	}
+
+	external := &v1alpha1.Cluster{}
+	if err := scheme.Convert(internal, external, nil); err != nil {
+		t.Fatalf("failed to convert cluster to v1alpha1: %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(external.Spec.ResourceModels, v1alpha1.DefaultResourceModels()) {
+		t.Errorf("default resource models changed after conversion: %v", external.Spec.ResourceModels)
+	}
+
+	roundTrip := &clusterkubellmio.Cluster{}
+	if err := scheme.Convert(external, roundTrip, nil); err != nil {
+		t.Fatalf("failed to convert cluster back to the internal version: %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(roundTrip, internal) {
+		t.Errorf("cluster changed after round trip:\nexpected %v\ngot %v", internal, roundTrip)
+	}
+}
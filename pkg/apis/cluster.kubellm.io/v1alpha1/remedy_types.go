@@ -0,0 +1,134 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ResourceKindRemedy is kind name of Remedy.
+	ResourceKindRemedy = "Remedy"
+	// ResourceSingularRemedy is singular name of Remedy.
+	ResourceSingularRemedy = "remedy"
+	// ResourcePluralRemedy is plural name of Remedy.
+	ResourcePluralRemedy = "remedies"
+	// ResourceNamespaceScopedRemedy indicates if Remedy is NamespaceScoped.
+	ResourceNamespaceScopedRemedy = false
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster"
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen=true
+// +k8s:openapi-gen=true
+// +k8s:client-gen=true
+// +genclient
+// +genclient:noStatus
+// +k8s:validation-gen=true
+// +k8s:defaulter-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// Remedy represents the cluster-level management strategies based on cluster conditions.
+// The resulting actions of all Remedies are written into the RemedyActions of
+// matched clusters' status.
+type Remedy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the desired behavior of Remedy.
+	// +required
+	Spec RemedySpec `json:"spec"`
+}
+
+// RemedySpec represents the desired behavior of Remedy.
+type RemedySpec struct {
+	// ClusterAffinity specifies the clusters that Remedy needs to pay attention to.
+	// For clusters that meet the DecisionMatches, Actions will be performed.
+	// If ClusterAffinity is nil, the Remedy applies to all clusters.
+	// +optional
+	ClusterAffinity *ClusterAffinity `json:"clusterAffinity,omitempty"`
+
+	// DecisionMatches indicates the decision matches of triggering the remedy
+	// system to perform the actions. As long as any one DecisionMatch matches,
+	// the Actions will be performed.
+	// If DecisionMatches is empty, the Actions will be performed immediately
+	// on all clusters selected by ClusterAffinity.
+	// +optional
+	DecisionMatches []DecisionMatch `json:"decisionMatches,omitempty"`
+
+	// Actions specifies the actions that the remedy system needs to perform.
+	// If empty, no action will be performed.
+	// +optional
+	Actions []RemedyAction `json:"actions,omitempty"`
+}
+
+// ClusterAffinity represents the filter to select clusters.
+type ClusterAffinity struct {
+	// ClusterNames is the list of clusters to be selected.
+	// +optional
+	ClusterNames []string `json:"clusterNames,omitempty"`
+}
+
+// DecisionMatch represents the decision match detail of activating the remedy system.
+type DecisionMatch struct {
+	// ClusterConditionMatch describes the cluster condition requirement.
+	// +optional
+	ClusterConditionMatch *ClusterConditionRequirement `json:"clusterConditionMatch,omitempty"`
+}
+
+// ClusterConditionRequirement describes the Cluster condition requirement details.
+// E.g. {ConditionType: Ready, Operator: Equal, ConditionStatus: False, Duration: 5m}
+// matches the clusters whose Ready condition has been False for at least 5 minutes.
+type ClusterConditionRequirement struct {
+	// ConditionType specifies the ClusterStatus condition type, e.g. Ready or
+	// a custom type such as ServiceDomainNameResolutionReady.
+	// +required
+	ConditionType string `json:"conditionType"`
+
+	// Operator represents a conditionType's relationship to a conditionStatus.
+	// Valid operators are Equal, NotEqual.
+	// +kubebuilder:validation:Enum=Equal;NotEqual
+	// +required
+	Operator ClusterConditionOperator `json:"operator"`
+
+	// ConditionStatus specifies the ClusterStatus condition status.
+	// +required
+	ConditionStatus metav1.ConditionStatus `json:"conditionStatus"`
+
+	// Duration is the minimum time the condition status must have satisfied the
+	// requirement, measured from the condition's LastTransitionTime.
+	// If not specified, the requirement matches as soon as it is satisfied.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// ClusterConditionOperator is the set of operators that can be used in the cluster condition requirement.
+type ClusterConditionOperator string
+
+const (
+	// ClusterConditionEqual means equal match.
+	ClusterConditionEqual ClusterConditionOperator = "Equal"
+	// ClusterConditionNotEqual means not equal match.
+	ClusterConditionNotEqual ClusterConditionOperator = "NotEqual"
+)
+
+// RemedyAction represents the action type the remedy system needs to perform.
+type RemedyAction string
+
+const (
+	// TrafficControl indicates that the cluster requires traffic control.
+	TrafficControl RemedyAction = "TrafficControl"
+	// StopScheduling indicates that no new workloads should be scheduled to the cluster.
+	StopScheduling RemedyAction = "StopScheduling"
+)
+
+// +k8s:deepcopy-gen=true
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RemedyList contains a list of Remedy.
+type RemedyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items holds a list of Remedy.
+	Items []Remedy `json:"items"`
+}
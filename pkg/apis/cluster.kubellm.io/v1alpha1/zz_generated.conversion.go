@@ -25,8 +25,9 @@ import (
 	unsafe "unsafe"
 
 	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -78,6 +79,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAffinity)(nil), (*clusterkubellmio.ClusterAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAffinity_To_clusterkubellmio_ClusterAffinity(a.(*ClusterAffinity), b.(*clusterkubellmio.ClusterAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*clusterkubellmio.ClusterAffinity)(nil), (*ClusterAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_clusterkubellmio_ClusterAffinity_To_v1alpha1_ClusterAffinity(a.(*clusterkubellmio.ClusterAffinity), b.(*ClusterAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterConditionRequirement)(nil), (*clusterkubellmio.ClusterConditionRequirement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterConditionRequirement_To_clusterkubellmio_ClusterConditionRequirement(a.(*ClusterConditionRequirement), b.(*clusterkubellmio.ClusterConditionRequirement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*clusterkubellmio.ClusterConditionRequirement)(nil), (*ClusterConditionRequirement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_clusterkubellmio_ClusterConditionRequirement_To_v1alpha1_ClusterConditionRequirement(a.(*clusterkubellmio.ClusterConditionRequirement), b.(*ClusterConditionRequirement), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterList)(nil), (*clusterkubellmio.ClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ClusterList_To_clusterkubellmio_ClusterList(a.(*ClusterList), b.(*clusterkubellmio.ClusterList), scope)
 	}); err != nil {
@@ -118,6 +139,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DecisionMatch)(nil), (*clusterkubellmio.DecisionMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DecisionMatch_To_clusterkubellmio_DecisionMatch(a.(*DecisionMatch), b.(*clusterkubellmio.DecisionMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*clusterkubellmio.DecisionMatch)(nil), (*DecisionMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_clusterkubellmio_DecisionMatch_To_v1alpha1_DecisionMatch(a.(*clusterkubellmio.DecisionMatch), b.(*DecisionMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GPUSummary)(nil), (*clusterkubellmio.GPUSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GPUSummary_To_clusterkubellmio_GPUSummary(a.(*GPUSummary), b.(*clusterkubellmio.GPUSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*clusterkubellmio.GPUSummary)(nil), (*GPUSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_clusterkubellmio_GPUSummary_To_v1alpha1_GPUSummary(a.(*clusterkubellmio.GPUSummary), b.(*GPUSummary), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LocalSecretReference)(nil), (*clusterkubellmio.LocalSecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LocalSecretReference_To_clusterkubellmio_LocalSecretReference(a.(*LocalSecretReference), b.(*clusterkubellmio.LocalSecretReference), scope)
 	}); err != nil {
@@ -138,6 +179,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Remedy)(nil), (*clusterkubellmio.Remedy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Remedy_To_clusterkubellmio_Remedy(a.(*Remedy), b.(*clusterkubellmio.Remedy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*clusterkubellmio.Remedy)(nil), (*Remedy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_clusterkubellmio_Remedy_To_v1alpha1_Remedy(a.(*clusterkubellmio.Remedy), b.(*Remedy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RemedyList)(nil), (*clusterkubellmio.RemedyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RemedyList_To_clusterkubellmio_RemedyList(a.(*RemedyList), b.(*clusterkubellmio.RemedyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*clusterkubellmio.RemedyList)(nil), (*RemedyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_clusterkubellmio_RemedyList_To_v1alpha1_RemedyList(a.(*clusterkubellmio.RemedyList), b.(*RemedyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RemedySpec)(nil), (*clusterkubellmio.RemedySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RemedySpec_To_clusterkubellmio_RemedySpec(a.(*RemedySpec), b.(*clusterkubellmio.RemedySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*clusterkubellmio.RemedySpec)(nil), (*RemedySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_clusterkubellmio_RemedySpec_To_v1alpha1_RemedySpec(a.(*clusterkubellmio.RemedySpec), b.(*RemedySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceModel)(nil), (*clusterkubellmio.ResourceModel)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourceModel_To_clusterkubellmio_ResourceModel(a.(*ResourceModel), b.(*clusterkubellmio.ResourceModel), scope)
 	}); err != nil {
@@ -274,6 +345,52 @@ func Convert_clusterkubellmio_Cluster_To_v1alpha1_Cluster(in *clusterkubellmio.C
 	return autoConvert_clusterkubellmio_Cluster_To_v1alpha1_Cluster(in, out, s)
 }
 
+func autoConvert_v1alpha1_ClusterAffinity_To_clusterkubellmio_ClusterAffinity(in *ClusterAffinity, out *clusterkubellmio.ClusterAffinity, s conversion.Scope) error {
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterAffinity_To_clusterkubellmio_ClusterAffinity is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterAffinity_To_clusterkubellmio_ClusterAffinity(in *ClusterAffinity, out *clusterkubellmio.ClusterAffinity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterAffinity_To_clusterkubellmio_ClusterAffinity(in, out, s)
+}
+
+func autoConvert_clusterkubellmio_ClusterAffinity_To_v1alpha1_ClusterAffinity(in *clusterkubellmio.ClusterAffinity, out *ClusterAffinity, s conversion.Scope) error {
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	return nil
+}
+
+// Convert_clusterkubellmio_ClusterAffinity_To_v1alpha1_ClusterAffinity is an autogenerated conversion function.
+func Convert_clusterkubellmio_ClusterAffinity_To_v1alpha1_ClusterAffinity(in *clusterkubellmio.ClusterAffinity, out *ClusterAffinity, s conversion.Scope) error {
+	return autoConvert_clusterkubellmio_ClusterAffinity_To_v1alpha1_ClusterAffinity(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterConditionRequirement_To_clusterkubellmio_ClusterConditionRequirement(in *ClusterConditionRequirement, out *clusterkubellmio.ClusterConditionRequirement, s conversion.Scope) error {
+	out.ConditionType = in.ConditionType
+	out.Operator = clusterkubellmio.ClusterConditionOperator(in.Operator)
+	out.ConditionStatus = v1.ConditionStatus(in.ConditionStatus)
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterConditionRequirement_To_clusterkubellmio_ClusterConditionRequirement is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterConditionRequirement_To_clusterkubellmio_ClusterConditionRequirement(in *ClusterConditionRequirement, out *clusterkubellmio.ClusterConditionRequirement, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterConditionRequirement_To_clusterkubellmio_ClusterConditionRequirement(in, out, s)
+}
+
+func autoConvert_clusterkubellmio_ClusterConditionRequirement_To_v1alpha1_ClusterConditionRequirement(in *clusterkubellmio.ClusterConditionRequirement, out *ClusterConditionRequirement, s conversion.Scope) error {
+	out.ConditionType = in.ConditionType
+	out.Operator = ClusterConditionOperator(in.Operator)
+	out.ConditionStatus = v1.ConditionStatus(in.ConditionStatus)
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_clusterkubellmio_ClusterConditionRequirement_To_v1alpha1_ClusterConditionRequirement is an autogenerated conversion function.
+func Convert_clusterkubellmio_ClusterConditionRequirement_To_v1alpha1_ClusterConditionRequirement(in *clusterkubellmio.ClusterConditionRequirement, out *ClusterConditionRequirement, s conversion.Scope) error {
+	return autoConvert_clusterkubellmio_ClusterConditionRequirement_To_v1alpha1_ClusterConditionRequirement(in, out, s)
+}
+
 func autoConvert_v1alpha1_ClusterList_To_clusterkubellmio_ClusterList(in *ClusterList, out *clusterkubellmio.ClusterList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]clusterkubellmio.Cluster)(unsafe.Pointer(&in.Items))
@@ -337,6 +454,7 @@ func Convert_url_Values_To_v1alpha1_ClusterProxyOptions(in *url.Values, out *Clu
 func autoConvert_v1alpha1_ClusterSpec_To_clusterkubellmio_ClusterSpec(in *ClusterSpec, out *clusterkubellmio.ClusterSpec, s conversion.Scope) error {
 	out.ID = in.ID
 	out.DisplayName = in.DisplayName
+	out.SyncMode = clusterkubellmio.ClusterSyncMode(in.SyncMode)
 	out.APIEndpoint = in.APIEndpoint
 	out.SecretRef = (*clusterkubellmio.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
 	out.ImpersonatorSecretRef = (*clusterkubellmio.LocalSecretReference)(unsafe.Pointer(in.ImpersonatorSecretRef))
@@ -345,7 +463,7 @@ func autoConvert_v1alpha1_ClusterSpec_To_clusterkubellmio_ClusterSpec(in *Cluste
 	out.ProxyHeader = *(*map[string]string)(unsafe.Pointer(&in.ProxyHeader))
 	out.Provider = in.Provider
 	out.Region = in.Region
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	out.ResourceModels = *(*[]clusterkubellmio.ResourceModel)(unsafe.Pointer(&in.ResourceModels))
 	return nil
 }
@@ -358,6 +476,7 @@ func Convert_v1alpha1_ClusterSpec_To_clusterkubellmio_ClusterSpec(in *ClusterSpe
 func autoConvert_clusterkubellmio_ClusterSpec_To_v1alpha1_ClusterSpec(in *clusterkubellmio.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
 	out.ID = in.ID
 	out.DisplayName = in.DisplayName
+	out.SyncMode = ClusterSyncMode(in.SyncMode)
 	out.APIEndpoint = in.APIEndpoint
 	out.SecretRef = (*LocalSecretReference)(unsafe.Pointer(in.SecretRef))
 	out.ImpersonatorSecretRef = (*LocalSecretReference)(unsafe.Pointer(in.ImpersonatorSecretRef))
@@ -366,7 +485,7 @@ func autoConvert_clusterkubellmio_ClusterSpec_To_v1alpha1_ClusterSpec(in *cluste
 	out.ProxyHeader = *(*map[string]string)(unsafe.Pointer(&in.ProxyHeader))
 	out.Provider = in.Provider
 	out.Region = in.Region
-	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
 	out.ResourceModels = *(*[]ResourceModel)(unsafe.Pointer(&in.ResourceModels))
 	return nil
 }
@@ -379,9 +498,11 @@ func Convert_clusterkubellmio_ClusterSpec_To_v1alpha1_ClusterSpec(in *clusterkub
 func autoConvert_v1alpha1_ClusterStatus_To_clusterkubellmio_ClusterStatus(in *ClusterStatus, out *clusterkubellmio.ClusterStatus, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
 	out.APIEnablements = *(*[]clusterkubellmio.APIEnablement)(unsafe.Pointer(&in.APIEnablements))
-	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.LastHeartbeatTime = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTime))
 	out.NodeSummary = (*clusterkubellmio.NodeSummary)(unsafe.Pointer(in.NodeSummary))
 	out.ResourceSummary = (*clusterkubellmio.ResourceSummary)(unsafe.Pointer(in.ResourceSummary))
+	out.GPUInventory = *(*[]clusterkubellmio.GPUSummary)(unsafe.Pointer(&in.GPUInventory))
 	out.RemedyActions = *(*[]string)(unsafe.Pointer(&in.RemedyActions))
 	return nil
 }
@@ -394,9 +515,11 @@ func Convert_v1alpha1_ClusterStatus_To_clusterkubellmio_ClusterStatus(in *Cluste
 func autoConvert_clusterkubellmio_ClusterStatus_To_v1alpha1_ClusterStatus(in *clusterkubellmio.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
 	out.APIEnablements = *(*[]APIEnablement)(unsafe.Pointer(&in.APIEnablements))
-	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.LastHeartbeatTime = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTime))
 	out.NodeSummary = (*NodeSummary)(unsafe.Pointer(in.NodeSummary))
 	out.ResourceSummary = (*ResourceSummary)(unsafe.Pointer(in.ResourceSummary))
+	out.GPUInventory = *(*[]GPUSummary)(unsafe.Pointer(&in.GPUInventory))
 	out.RemedyActions = *(*[]string)(unsafe.Pointer(&in.RemedyActions))
 	return nil
 }
@@ -406,6 +529,60 @@ func Convert_clusterkubellmio_ClusterStatus_To_v1alpha1_ClusterStatus(in *cluste
 	return autoConvert_clusterkubellmio_ClusterStatus_To_v1alpha1_ClusterStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_DecisionMatch_To_clusterkubellmio_DecisionMatch(in *DecisionMatch, out *clusterkubellmio.DecisionMatch, s conversion.Scope) error {
+	out.ClusterConditionMatch = (*clusterkubellmio.ClusterConditionRequirement)(unsafe.Pointer(in.ClusterConditionMatch))
+	return nil
+}
+
+// Convert_v1alpha1_DecisionMatch_To_clusterkubellmio_DecisionMatch is an autogenerated conversion function.
+func Convert_v1alpha1_DecisionMatch_To_clusterkubellmio_DecisionMatch(in *DecisionMatch, out *clusterkubellmio.DecisionMatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DecisionMatch_To_clusterkubellmio_DecisionMatch(in, out, s)
+}
+
+func autoConvert_clusterkubellmio_DecisionMatch_To_v1alpha1_DecisionMatch(in *clusterkubellmio.DecisionMatch, out *DecisionMatch, s conversion.Scope) error {
+	out.ClusterConditionMatch = (*ClusterConditionRequirement)(unsafe.Pointer(in.ClusterConditionMatch))
+	return nil
+}
+
+// Convert_clusterkubellmio_DecisionMatch_To_v1alpha1_DecisionMatch is an autogenerated conversion function.
+func Convert_clusterkubellmio_DecisionMatch_To_v1alpha1_DecisionMatch(in *clusterkubellmio.DecisionMatch, out *DecisionMatch, s conversion.Scope) error {
+	return autoConvert_clusterkubellmio_DecisionMatch_To_v1alpha1_DecisionMatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_GPUSummary_To_clusterkubellmio_GPUSummary(in *GPUSummary, out *clusterkubellmio.GPUSummary, s conversion.Scope) error {
+	out.ResourceName = corev1.ResourceName(in.ResourceName)
+	out.Product = in.Product
+	out.Memory = (*resource.Quantity)(unsafe.Pointer(in.Memory))
+	out.MIGProfile = in.MIGProfile
+	out.NodeCount = in.NodeCount
+	out.Allocatable = in.Allocatable
+	out.Allocated = in.Allocated
+	out.Free = in.Free
+	return nil
+}
+
+// Convert_v1alpha1_GPUSummary_To_clusterkubellmio_GPUSummary is an autogenerated conversion function.
+func Convert_v1alpha1_GPUSummary_To_clusterkubellmio_GPUSummary(in *GPUSummary, out *clusterkubellmio.GPUSummary, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GPUSummary_To_clusterkubellmio_GPUSummary(in, out, s)
+}
+
+func autoConvert_clusterkubellmio_GPUSummary_To_v1alpha1_GPUSummary(in *clusterkubellmio.GPUSummary, out *GPUSummary, s conversion.Scope) error {
+	out.ResourceName = corev1.ResourceName(in.ResourceName)
+	out.Product = in.Product
+	out.Memory = (*resource.Quantity)(unsafe.Pointer(in.Memory))
+	out.MIGProfile = in.MIGProfile
+	out.NodeCount = in.NodeCount
+	out.Allocatable = in.Allocatable
+	out.Allocated = in.Allocated
+	out.Free = in.Free
+	return nil
+}
+
+// Convert_clusterkubellmio_GPUSummary_To_v1alpha1_GPUSummary is an autogenerated conversion function.
+func Convert_clusterkubellmio_GPUSummary_To_v1alpha1_GPUSummary(in *clusterkubellmio.GPUSummary, out *GPUSummary, s conversion.Scope) error {
+	return autoConvert_clusterkubellmio_GPUSummary_To_v1alpha1_GPUSummary(in, out, s)
+}
+
 func autoConvert_v1alpha1_LocalSecretReference_To_clusterkubellmio_LocalSecretReference(in *LocalSecretReference, out *clusterkubellmio.LocalSecretReference, s conversion.Scope) error {
 	out.Namespace = in.Namespace
 	out.Name = in.Name
@@ -450,6 +627,78 @@ func Convert_clusterkubellmio_NodeSummary_To_v1alpha1_NodeSummary(in *clusterkub
 	return autoConvert_clusterkubellmio_NodeSummary_To_v1alpha1_NodeSummary(in, out, s)
 }
 
+func autoConvert_v1alpha1_Remedy_To_clusterkubellmio_Remedy(in *Remedy, out *clusterkubellmio.Remedy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RemedySpec_To_clusterkubellmio_RemedySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Remedy_To_clusterkubellmio_Remedy is an autogenerated conversion function.
+func Convert_v1alpha1_Remedy_To_clusterkubellmio_Remedy(in *Remedy, out *clusterkubellmio.Remedy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Remedy_To_clusterkubellmio_Remedy(in, out, s)
+}
+
+func autoConvert_clusterkubellmio_Remedy_To_v1alpha1_Remedy(in *clusterkubellmio.Remedy, out *Remedy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_clusterkubellmio_RemedySpec_To_v1alpha1_RemedySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_clusterkubellmio_Remedy_To_v1alpha1_Remedy is an autogenerated conversion function.
+func Convert_clusterkubellmio_Remedy_To_v1alpha1_Remedy(in *clusterkubellmio.Remedy, out *Remedy, s conversion.Scope) error {
+	return autoConvert_clusterkubellmio_Remedy_To_v1alpha1_Remedy(in, out, s)
+}
+
+func autoConvert_v1alpha1_RemedyList_To_clusterkubellmio_RemedyList(in *RemedyList, out *clusterkubellmio.RemedyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]clusterkubellmio.Remedy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_RemedyList_To_clusterkubellmio_RemedyList is an autogenerated conversion function.
+func Convert_v1alpha1_RemedyList_To_clusterkubellmio_RemedyList(in *RemedyList, out *clusterkubellmio.RemedyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RemedyList_To_clusterkubellmio_RemedyList(in, out, s)
+}
+
+func autoConvert_clusterkubellmio_RemedyList_To_v1alpha1_RemedyList(in *clusterkubellmio.RemedyList, out *RemedyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Remedy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_clusterkubellmio_RemedyList_To_v1alpha1_RemedyList is an autogenerated conversion function.
+func Convert_clusterkubellmio_RemedyList_To_v1alpha1_RemedyList(in *clusterkubellmio.RemedyList, out *RemedyList, s conversion.Scope) error {
+	return autoConvert_clusterkubellmio_RemedyList_To_v1alpha1_RemedyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RemedySpec_To_clusterkubellmio_RemedySpec(in *RemedySpec, out *clusterkubellmio.RemedySpec, s conversion.Scope) error {
+	out.ClusterAffinity = (*clusterkubellmio.ClusterAffinity)(unsafe.Pointer(in.ClusterAffinity))
+	out.DecisionMatches = *(*[]clusterkubellmio.DecisionMatch)(unsafe.Pointer(&in.DecisionMatches))
+	out.Actions = *(*[]clusterkubellmio.RemedyAction)(unsafe.Pointer(&in.Actions))
+	return nil
+}
+
+// Convert_v1alpha1_RemedySpec_To_clusterkubellmio_RemedySpec is an autogenerated conversion function.
+func Convert_v1alpha1_RemedySpec_To_clusterkubellmio_RemedySpec(in *RemedySpec, out *clusterkubellmio.RemedySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RemedySpec_To_clusterkubellmio_RemedySpec(in, out, s)
+}
+
+func autoConvert_clusterkubellmio_RemedySpec_To_v1alpha1_RemedySpec(in *clusterkubellmio.RemedySpec, out *RemedySpec, s conversion.Scope) error {
+	out.ClusterAffinity = (*ClusterAffinity)(unsafe.Pointer(in.ClusterAffinity))
+	out.DecisionMatches = *(*[]DecisionMatch)(unsafe.Pointer(&in.DecisionMatches))
+	out.Actions = *(*[]RemedyAction)(unsafe.Pointer(&in.Actions))
+	return nil
+}
+
+// Convert_clusterkubellmio_RemedySpec_To_v1alpha1_RemedySpec is an autogenerated conversion function.
+func Convert_clusterkubellmio_RemedySpec_To_v1alpha1_RemedySpec(in *clusterkubellmio.RemedySpec, out *RemedySpec, s conversion.Scope) error {
+	return autoConvert_clusterkubellmio_RemedySpec_To_v1alpha1_RemedySpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResourceModel_To_clusterkubellmio_ResourceModel(in *ResourceModel, out *clusterkubellmio.ResourceModel, s conversion.Scope) error {
 	out.Grade = in.Grade
 	out.Ranges = *(*[]clusterkubellmio.ResourceModelRange)(unsafe.Pointer(&in.Ranges))
@@ -473,7 +722,7 @@ func Convert_clusterkubellmio_ResourceModel_To_v1alpha1_ResourceModel(in *cluste
 }
 
 func autoConvert_v1alpha1_ResourceModelRange_To_clusterkubellmio_ResourceModelRange(in *ResourceModelRange, out *clusterkubellmio.ResourceModelRange, s conversion.Scope) error {
-	out.Name = v1.ResourceName(in.Name)
+	out.Name = corev1.ResourceName(in.Name)
 	out.Min = in.Min
 	out.Max = in.Max
 	return nil
@@ -485,7 +734,7 @@ func Convert_v1alpha1_ResourceModelRange_To_clusterkubellmio_ResourceModelRange(
 }
 
 func autoConvert_clusterkubellmio_ResourceModelRange_To_v1alpha1_ResourceModelRange(in *clusterkubellmio.ResourceModelRange, out *ResourceModelRange, s conversion.Scope) error {
-	out.Name = v1.ResourceName(in.Name)
+	out.Name = corev1.ResourceName(in.Name)
 	out.Min = in.Min
 	out.Max = in.Max
 	return nil
@@ -497,9 +746,9 @@ func Convert_clusterkubellmio_ResourceModelRange_To_v1alpha1_ResourceModelRange(
 }
 
 func autoConvert_v1alpha1_ResourceSummary_To_clusterkubellmio_ResourceSummary(in *ResourceSummary, out *clusterkubellmio.ResourceSummary, s conversion.Scope) error {
-	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.Allocating = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocating))
-	out.Allocated = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Allocatable = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Allocating = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocating))
+	out.Allocated = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocated))
 	out.AllocatableModelings = *(*[]clusterkubellmio.AllocatableModeling)(unsafe.Pointer(&in.AllocatableModelings))
 	return nil
 }
@@ -510,9 +759,9 @@ func Convert_v1alpha1_ResourceSummary_To_clusterkubellmio_ResourceSummary(in *Re
 }
 
 func autoConvert_clusterkubellmio_ResourceSummary_To_v1alpha1_ResourceSummary(in *clusterkubellmio.ResourceSummary, out *ResourceSummary, s conversion.Scope) error {
-	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.Allocating = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocating))
-	out.Allocated = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Allocatable = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Allocating = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocating))
+	out.Allocated = *(*corev1.ResourceList)(unsafe.Pointer(&in.Allocated))
 	out.AllocatableModelings = *(*[]AllocatableModeling)(unsafe.Pointer(&in.AllocatableModelings))
 	return nil
 }
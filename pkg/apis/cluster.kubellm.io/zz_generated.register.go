@@ -63,6 +63,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Cluster{},
 		&ClusterList{},
 		&ClusterProxyOptions{},
+		&Remedy{},
+		&RemedyList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	return nil
@@ -177,6 +177,39 @@ const (
 	UserPasswordExpired UserState = "PasswordExpired"
 )
 
+// User 的条件类型。Approved 与 Locked 由审批流程或管理员写入，其余由 kubellm-controller 根据 Spec 与登录记录计算。
+// kubellm-controller 按以下优先级由条件推导 State：
+// Disabled（LoginDisabled 为 True 或 Approved 为 False 且原因为 Rejected）> Locked > PendingApproval
+// > AuthLimitExceeded > PasswordExpired > Active。
+const (
+	// UserConditionApproved 表示用户是否已通过审批。没有该条件的用户由管理员创建，视为已通过审批。
+	UserConditionApproved = "Approved"
+	// UserConditionLoginDisabled 反映 Spec.LoginDisabled。
+	UserConditionLoginDisabled = "LoginDisabled"
+	// UserConditionLocked 表示用户被管理员锁定，只能由管理员通过 status 子资源解除。
+	UserConditionLocked = "Locked"
+	// UserConditionAuthLimitExceeded 表示用户因连续登录失败次数过多被暂时限制登录，冷却时间过后自动解除。
+	UserConditionAuthLimitExceeded = "AuthLimitExceeded"
+	// UserConditionPasswordExpired 表示用户的密码已超过密码策略规定的最长使用时间。
+	UserConditionPasswordExpired = "PasswordExpired"
+)
+
+// Approved 条件的原因。
+const (
+	// UserReasonApproved 表示管理员通过了用户的注册申请。
+	UserReasonApproved = "Approved"
+	// UserReasonAutoApproved 表示用户验证了电子邮件地址，且其域名在自动审批的白名单中。
+	UserReasonAutoApproved = "AutoApproved"
+	// UserReasonPendingApproval 表示用户自注册后等待管理员审批。
+	UserReasonPendingApproval = "PendingApproval"
+	// UserReasonRejected 表示管理员拒绝了用户的注册申请。
+	UserReasonRejected = "Rejected"
+)
+
+// SystemPrefix 是 Kubernetes 保留给系统用户与组的前缀。User 的名称与组不能使用该前缀，
+// 成员集群中的 impersonator 也不能模拟这些身份，避免 proxy 子资源模拟 system:masters 等身份。
+const SystemPrefix = "system:"
+
 // UserStatus 定义用户的观察到的状态。
 // @Description UserStatus包含了用户的运行时状态信息。
 type UserStatus struct {
@@ -219,6 +252,17 @@ type UserStatus struct {
 	// +optional
 	FailedLoginAttempts *int32 `json:"failedLoginAttempts,omitempty" protobuf:"varint,7,opt,name=failedLoginAttempts"`
 
+	// FirstFailedLoginTime 是当前统计窗口中第一次登录失败的时间。距离该时间超过统计窗口后，FailedLoginAttempts 重新开始计数。
+	// @Description 当前统计窗口中第一次登录失败的时间戳。
+	// +optional
+	FirstFailedLoginTime *metav1.Time `json:"firstFailedLoginTime,omitempty" protobuf:"bytes,13,opt,name=firstFailedLoginTime"`
+
+	// LastFailedLoginTime 是最近一次登录失败的时间。
+	// 用户因失败次数过多进入 AuthLimitExceeded 状态后，从该时间起经过冷却时间自动解除限制。
+	// @Description 用户最近一次登录失败的时间戳。
+	// +optional
+	LastFailedLoginTime *metav1.Time `json:"lastFailedLoginTime,omitempty" protobuf:"bytes,11,opt,name=lastFailedLoginTime"`
+
 	// PasswordExpiryTime 是用户当前密码的过期时间。如果为空，表示密码永不过期或策略未启用。
 	// @Description 用户当前密码的过期时间。
 	// +optional
@@ -229,6 +273,22 @@ type UserStatus struct {
 	// +optional
 	PasswordLastChangedTime *metav1.Time `json:"passwordLastChangedTime,omitempty" protobuf:"bytes,9,opt,name=passwordLastChangedTime"`
 
+	// PasswordHistory 保存最近使用过的密码的 bcrypt 哈希，最新的在前，用于在修改密码时拒绝重复使用。
+	// 条目数量由密码策略的 historyDepth 决定。此字段由 kubellm-apiserver 维护，不会返回给客户端，也不能通过 status 子资源修改。
+	// @Description 最近使用过的密码的哈希，只写不读。
+	// +optional
+	// +listType=atomic
+	PasswordHistory []string `json:"passwordHistory,omitempty" protobuf:"bytes,12,rep,name=passwordHistory"`
+
+	// RefreshTokenSessions 记录每次登录开始的刷新令牌会话。刷新令牌每次使用后轮换，会话中只有最新签发的刷新令牌有效，
+	// 已经使用过的刷新令牌再次出现说明它可能已经泄露，此时撤销整个会话。
+	// 此字段由 kubellm-apiserver 维护，不会返回给客户端，也不能通过 status 子资源修改。
+	// @Description 刷新令牌会话，只写不读。
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	RefreshTokenSessions []RefreshTokenSession `json:"refreshTokenSessions,omitempty" protobuf:"bytes,14,rep,name=refreshTokenSessions"`
+
 	// Conditions 包含用户当前状态的结构化条件列表。
 	// @Description 用户的当前状况的详细条件列表。
 	// +optional
@@ -239,6 +299,19 @@ type UserStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,10,rep,name=conditions"`
 }
 
+// RefreshTokenSession 是一次登录开始的刷新令牌会话。
+// @Description RefreshTokenSession记录会话中当前有效的刷新令牌。
+type RefreshTokenSession struct {
+	// ID 是会话的标识，同一会话中签发的刷新令牌的 sid 声明相同。
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+
+	// TokenID 是会话中当前有效的刷新令牌的 jti。
+	TokenID string `json:"tokenID" protobuf:"bytes,2,opt,name=tokenID"`
+
+	// ExpirationTime 是当前有效的刷新令牌的过期时间，过期的会话在用户下一次登录或刷新时清理。
+	ExpirationTime metav1.Time `json:"expirationTime" protobuf:"bytes,3,opt,name=expirationTime"`
+}
+
 // +kubebuilder:object:root=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -254,3 +327,19 @@ type UserList struct {
 	// @Required true
 	Items []User `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// +k8s:deepcopy-gen=true
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UserApprovalOptions 是 User 的 approve 与 reject 子资源的查询参数。
+// @Description 审批自注册用户时的参数。
+type UserApprovalOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Message 是审批意见，记录在 Approved 条件中，拒绝时会作为无法登录的原因展示给用户。
+	// @Description 审批意见。
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty" protobuf:"bytes,1,opt,name=message"`
+}
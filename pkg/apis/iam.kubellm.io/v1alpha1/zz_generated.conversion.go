@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	url "net/url"
 	unsafe "unsafe"
 
 	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
@@ -36,6 +37,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*RefreshTokenSession)(nil), (*iamkubellmio.RefreshTokenSession)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RefreshTokenSession_To_iamkubellmio_RefreshTokenSession(a.(*RefreshTokenSession), b.(*iamkubellmio.RefreshTokenSession), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*iamkubellmio.RefreshTokenSession)(nil), (*RefreshTokenSession)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_iamkubellmio_RefreshTokenSession_To_v1alpha1_RefreshTokenSession(a.(*iamkubellmio.RefreshTokenSession), b.(*RefreshTokenSession), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*User)(nil), (*iamkubellmio.User)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_User_To_iamkubellmio_User(a.(*User), b.(*iamkubellmio.User), scope)
 	}); err != nil {
@@ -46,6 +57,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*UserApprovalOptions)(nil), (*iamkubellmio.UserApprovalOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UserApprovalOptions_To_iamkubellmio_UserApprovalOptions(a.(*UserApprovalOptions), b.(*iamkubellmio.UserApprovalOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*iamkubellmio.UserApprovalOptions)(nil), (*UserApprovalOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_iamkubellmio_UserApprovalOptions_To_v1alpha1_UserApprovalOptions(a.(*iamkubellmio.UserApprovalOptions), b.(*UserApprovalOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*UserList)(nil), (*iamkubellmio.UserList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_UserList_To_iamkubellmio_UserList(a.(*UserList), b.(*iamkubellmio.UserList), scope)
 	}); err != nil {
@@ -76,9 +97,38 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*UserApprovalOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1alpha1_UserApprovalOptions(a.(*url.Values), b.(*UserApprovalOptions), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_RefreshTokenSession_To_iamkubellmio_RefreshTokenSession(in *RefreshTokenSession, out *iamkubellmio.RefreshTokenSession, s conversion.Scope) error {
+	out.ID = in.ID
+	out.TokenID = in.TokenID
+	out.ExpirationTime = in.ExpirationTime
+	return nil
+}
+
+// Convert_v1alpha1_RefreshTokenSession_To_iamkubellmio_RefreshTokenSession is an autogenerated conversion function.
+func Convert_v1alpha1_RefreshTokenSession_To_iamkubellmio_RefreshTokenSession(in *RefreshTokenSession, out *iamkubellmio.RefreshTokenSession, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RefreshTokenSession_To_iamkubellmio_RefreshTokenSession(in, out, s)
+}
+
+func autoConvert_iamkubellmio_RefreshTokenSession_To_v1alpha1_RefreshTokenSession(in *iamkubellmio.RefreshTokenSession, out *RefreshTokenSession, s conversion.Scope) error {
+	out.ID = in.ID
+	out.TokenID = in.TokenID
+	out.ExpirationTime = in.ExpirationTime
 	return nil
 }
 
+// Convert_iamkubellmio_RefreshTokenSession_To_v1alpha1_RefreshTokenSession is an autogenerated conversion function.
+func Convert_iamkubellmio_RefreshTokenSession_To_v1alpha1_RefreshTokenSession(in *iamkubellmio.RefreshTokenSession, out *RefreshTokenSession, s conversion.Scope) error {
+	return autoConvert_iamkubellmio_RefreshTokenSession_To_v1alpha1_RefreshTokenSession(in, out, s)
+}
+
 func autoConvert_v1alpha1_User_To_iamkubellmio_User(in *User, out *iamkubellmio.User, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_UserSpec_To_iamkubellmio_UserSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -111,6 +161,44 @@ func Convert_iamkubellmio_User_To_v1alpha1_User(in *iamkubellmio.User, out *User
 	return autoConvert_iamkubellmio_User_To_v1alpha1_User(in, out, s)
 }
 
+func autoConvert_v1alpha1_UserApprovalOptions_To_iamkubellmio_UserApprovalOptions(in *UserApprovalOptions, out *iamkubellmio.UserApprovalOptions, s conversion.Scope) error {
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_UserApprovalOptions_To_iamkubellmio_UserApprovalOptions is an autogenerated conversion function.
+func Convert_v1alpha1_UserApprovalOptions_To_iamkubellmio_UserApprovalOptions(in *UserApprovalOptions, out *iamkubellmio.UserApprovalOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UserApprovalOptions_To_iamkubellmio_UserApprovalOptions(in, out, s)
+}
+
+func autoConvert_iamkubellmio_UserApprovalOptions_To_v1alpha1_UserApprovalOptions(in *iamkubellmio.UserApprovalOptions, out *UserApprovalOptions, s conversion.Scope) error {
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_iamkubellmio_UserApprovalOptions_To_v1alpha1_UserApprovalOptions is an autogenerated conversion function.
+func Convert_iamkubellmio_UserApprovalOptions_To_v1alpha1_UserApprovalOptions(in *iamkubellmio.UserApprovalOptions, out *UserApprovalOptions, s conversion.Scope) error {
+	return autoConvert_iamkubellmio_UserApprovalOptions_To_v1alpha1_UserApprovalOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1alpha1_UserApprovalOptions(in *url.Values, out *UserApprovalOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["message"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Message, s); err != nil {
+			return err
+		}
+	} else {
+		out.Message = ""
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1alpha1_UserApprovalOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1alpha1_UserApprovalOptions(in *url.Values, out *UserApprovalOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1alpha1_UserApprovalOptions(in, out, s)
+}
+
 func autoConvert_v1alpha1_UserList_To_iamkubellmio_UserList(in *UserList, out *iamkubellmio.UserList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]iamkubellmio.User)(unsafe.Pointer(&in.Items))
@@ -185,8 +273,12 @@ func autoConvert_v1alpha1_UserStatus_To_iamkubellmio_UserStatus(in *UserStatus,
 	out.LastLoginTime = (*v1.Time)(unsafe.Pointer(in.LastLoginTime))
 	out.LastLoginIP = in.LastLoginIP
 	out.FailedLoginAttempts = (*int32)(unsafe.Pointer(in.FailedLoginAttempts))
+	out.FirstFailedLoginTime = (*v1.Time)(unsafe.Pointer(in.FirstFailedLoginTime))
+	out.LastFailedLoginTime = (*v1.Time)(unsafe.Pointer(in.LastFailedLoginTime))
 	out.PasswordExpiryTime = (*v1.Time)(unsafe.Pointer(in.PasswordExpiryTime))
 	out.PasswordLastChangedTime = (*v1.Time)(unsafe.Pointer(in.PasswordLastChangedTime))
+	out.PasswordHistory = *(*[]string)(unsafe.Pointer(&in.PasswordHistory))
+	out.RefreshTokenSessions = *(*[]iamkubellmio.RefreshTokenSession)(unsafe.Pointer(&in.RefreshTokenSessions))
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
@@ -204,8 +296,12 @@ func autoConvert_iamkubellmio_UserStatus_To_v1alpha1_UserStatus(in *iamkubellmio
 	out.LastLoginTime = (*v1.Time)(unsafe.Pointer(in.LastLoginTime))
 	out.LastLoginIP = in.LastLoginIP
 	out.FailedLoginAttempts = (*int32)(unsafe.Pointer(in.FailedLoginAttempts))
+	out.FirstFailedLoginTime = (*v1.Time)(unsafe.Pointer(in.FirstFailedLoginTime))
+	out.LastFailedLoginTime = (*v1.Time)(unsafe.Pointer(in.LastFailedLoginTime))
 	out.PasswordExpiryTime = (*v1.Time)(unsafe.Pointer(in.PasswordExpiryTime))
 	out.PasswordLastChangedTime = (*v1.Time)(unsafe.Pointer(in.PasswordLastChangedTime))
+	out.PasswordHistory = *(*[]string)(unsafe.Pointer(&in.PasswordHistory))
+	out.RefreshTokenSessions = *(*[]RefreshTokenSession)(unsafe.Pointer(&in.RefreshTokenSessions))
 	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
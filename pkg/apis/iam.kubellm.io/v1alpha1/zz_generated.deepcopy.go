@@ -25,6 +25,23 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RefreshTokenSession) DeepCopyInto(out *RefreshTokenSession) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RefreshTokenSession.
+func (in *RefreshTokenSession) DeepCopy() *RefreshTokenSession {
+	if in == nil {
+		return nil
+	}
+	out := new(RefreshTokenSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -53,6 +70,31 @@ func (in *User) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserApprovalOptions) DeepCopyInto(out *UserApprovalOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserApprovalOptions.
+func (in *UserApprovalOptions) DeepCopy() *UserApprovalOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(UserApprovalOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserApprovalOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserList) DeepCopyInto(out *UserList) {
 	*out = *in
@@ -128,6 +170,14 @@ func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.FirstFailedLoginTime != nil {
+		in, out := &in.FirstFailedLoginTime, &out.FirstFailedLoginTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailedLoginTime != nil {
+		in, out := &in.LastFailedLoginTime, &out.LastFailedLoginTime
+		*out = (*in).DeepCopy()
+	}
 	if in.PasswordExpiryTime != nil {
 		in, out := &in.PasswordExpiryTime, &out.PasswordExpiryTime
 		*out = (*in).DeepCopy()
@@ -136,6 +186,18 @@ func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 		in, out := &in.PasswordLastChangedTime, &out.PasswordLastChangedTime
 		*out = (*in).DeepCopy()
 	}
+	if in.PasswordHistory != nil {
+		in, out := &in.PasswordHistory, &out.PasswordHistory
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshTokenSessions != nil {
+		in, out := &in.RefreshTokenSessions, &out.RefreshTokenSessions
+		*out = make([]RefreshTokenSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
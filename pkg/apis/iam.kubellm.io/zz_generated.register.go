@@ -61,6 +61,7 @@ func init() {
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&User{},
+		&UserApprovalOptions{},
 		&UserList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
@@ -0,0 +1,336 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apiserver/pkg/registry/rest"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	componentbaseconfig "k8s.io/component-base/config"
+	"k8s.io/klog/v2"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	clusterinstall "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/install"
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	iaminstall "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/install"
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/auth/oauth"
+	"github.com/kubellm-io/kubellm/pkg/auth/password"
+	"github.com/kubellm-io/kubellm/pkg/auth/signup"
+	"github.com/kubellm-io/kubellm/pkg/auth/token"
+	clusterstorage "github.com/kubellm-io/kubellm/pkg/registry/cluster"
+	remedystorage "github.com/kubellm-io/kubellm/pkg/registry/cluster/remedy"
+	userstorage "github.com/kubellm-io/kubellm/pkg/registry/iam/user"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+	"github.com/kubellm-io/kubellm/pkg/util/tunnel"
+)
+
+var (
+	// Scheme 包含 kubellm-apiserver 提供的所有 API 类型（内部版本与外部版本）
+	Scheme = runtime.NewScheme()
+	// Codecs 提供针对 Scheme 中各版本的编解码器
+	Codecs = serializer.NewCodecFactory(Scheme)
+	// ParameterCodec 负责在 URL 查询参数与 Scheme 中的选项类型（如 ClusterProxyOptions）之间转换
+	ParameterCodec = runtime.NewParameterCodec(Scheme)
+)
+
+func init() {
+	clusterinstall.Install(Scheme)
+	iaminstall.Install(Scheme)
+
+	// 通用 API Server 需要在空的 v1 组下注册 ListOptions 等选项类型
+	metav1.AddToGroupVersion(Scheme, schema.GroupVersion{Version: "v1"})
+
+	unversioned := schema.GroupVersion{Group: "", Version: "v1"}
+	Scheme.AddUnversionedTypes(unversioned,
+		&metav1.Status{},
+		&metav1.APIVersions{},
+		&metav1.APIGroupList{},
+		&metav1.APIGroup{},
+		&metav1.APIResourceList{},
+	)
+}
+
+// ExtraConfig 保存 kubellm-apiserver 自定义的配置
+type ExtraConfig struct {
+	// KubeClient 是访问核心集群的客户端，没有配置核心集群时为空
+	KubeClient kubernetes.Interface
+	// Token 是本地用户登录时签发令牌的配置
+	Token TokenConfig
+	// PasswordPolicy 是创建与更新 User 时校验密码的策略
+	PasswordPolicy *password.Policy
+	// Signup 是本地用户自注册的配置
+	Signup SignupConfig
+	// Tunnel 是 Pull 模式反向隧道的配置
+	Tunnel TunnelConfig
+}
+
+// TunnelConfig 是 Pull 模式反向隧道的配置
+type TunnelConfig struct {
+	// LeaderElection 决定多个副本之间如何选出唯一维护隧道的活跃副本
+	LeaderElection componentbaseconfig.LeaderElectionConfiguration
+	// RelayBindAddress 与 RelayPort 是副本之间转发请求的 relay 监听的地址，
+	// 其他副本通过本副本的 PublicAddress 与 RelayPort 访问 relay
+	RelayBindAddress net.IP
+	RelayPort        int
+}
+
+// SignupConfig 是本地用户自注册的配置
+type SignupConfig struct {
+	// Enabled 为 true 时安装匿名可访问的自注册端点
+	Enabled bool
+	// TrustedProxies 是转发注册请求的反向代理的地址范围
+	TrustedProxies []*net.IPNet
+	// ClientRateLimit 是每个客户端 IP 每小时最多的注册请求数
+	ClientRateLimit int
+	// RateLimit 是所有客户端每分钟最多的注册请求数
+	RateLimit int
+	// AutoApproveEmailDomains 是验证电子邮件地址后自动通过审批的域名，为空时所有用户都需要管理员审批
+	AutoApproveEmailDomains []string
+	// EmailVerificationTTL 是验证链接的有效期
+	EmailVerificationTTL time.Duration
+	// SMTP 是发送验证邮件的 SMTP 服务器
+	SMTP SMTPConfig
+}
+
+// autoApprove 判断是否按电子邮件域名自动审批
+func (c SignupConfig) autoApprove() bool {
+	return c.Enabled && len(c.AutoApproveEmailDomains) > 0
+}
+
+// SMTPConfig 是发送邮件的 SMTP 服务器的配置
+type SMTPConfig struct {
+	// Address 是 SMTP 服务器的 host:port
+	Address string
+	// From 是发件人地址
+	From string
+	// Username 与 Password 用于 PLAIN 认证，Username 为空时不认证
+	Username string
+	Password string
+}
+
+// TokenConfig 是本地用户登录时签发令牌的配置
+type TokenConfig struct {
+	// Issuer 是令牌的 iss，也是 OpenID Connect 发现文档的地址前缀
+	Issuer string
+	// Audiences 是访问令牌的 aud
+	Audiences []string
+	// AccessTokenTTL 是访问令牌的有效期
+	AccessTokenTTL time.Duration
+	// RefreshTokenTTL 是刷新令牌的有效期
+	RefreshTokenTTL time.Duration
+	// KeyRotationPeriod 是签名密钥的轮换周期
+	KeyRotationPeriod time.Duration
+	// KeySecretNamespace 与 KeySecretName 指定核心集群中保存签名密钥的 Secret
+	KeySecretNamespace string
+	KeySecretName      string
+	// Lockout 决定连续登录失败多少次后暂时限制用户登录
+	Lockout userstorage.LockoutPolicy
+	// TrustedProxies 是转发登录请求的反向代理的地址范围
+	TrustedProxies []*net.IPNet
+}
+
+// Config 定义了 kubellm-apiserver 的完整配置
+type Config struct {
+	GenericConfig *genericapiserver.RecommendedConfig
+	ExtraConfig   ExtraConfig
+}
+
+// KubellmAPIServer 包含聚合 API Server 的运行状态
+type KubellmAPIServer struct {
+	GenericAPIServer *genericapiserver.GenericAPIServer
+}
+
+type completedConfig struct {
+	GenericConfig genericapiserver.CompletedConfig
+	ExtraConfig   *ExtraConfig
+}
+
+// CompletedConfig 内嵌私有指针，保证只能通过 Config.Complete 构造
+type CompletedConfig struct {
+	*completedConfig
+}
+
+// Complete 补全配置中未设置的字段
+func (cfg *Config) Complete() CompletedConfig {
+	if cfg.ExtraConfig.PasswordPolicy == nil {
+		cfg.ExtraConfig.PasswordPolicy = password.DefaultPolicy()
+	}
+	c := completedConfig{
+		cfg.GenericConfig.Complete(),
+		&cfg.ExtraConfig,
+	}
+
+	return CompletedConfig{&c}
+}
+
+// New 根据补全后的配置创建 KubellmAPIServer，并安装所有 API 组
+func (c completedConfig) New() (*KubellmAPIServer, error) {
+	genericServer, err := c.GenericConfig.New("kubellm-apiserver", genericapiserver.NewEmptyDelegate())
+	if err != nil {
+		return nil, err
+	}
+
+	s := &KubellmAPIServer{
+		GenericAPIServer: genericServer,
+	}
+
+	// cluster proxy 需要按名称查找 kubellm User，因此先创建 User 存储
+	userStorage, err := userstorage.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter, c.ExtraConfig.PasswordPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.installClusterAPIGroup(c, newUserGetter(userStorage.User)); err != nil {
+		return nil, err
+	}
+	if err := s.installIAMAPIGroup(userStorage); err != nil {
+		return nil, err
+	}
+	issuer, err := s.installOAuth(c, userStorage.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.installSignup(c, userStorage.Registration, issuer); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// installClusterAPIGroup 安装 cluster.kubellm.io 组
+func (s *KubellmAPIServer) installClusterAPIGroup(c completedConfig, userGetter clusterstorage.UserGetterFunc) error {
+	apiGroupInfo := genericapiserver.NewDefaultAPIGroupInfo(clusterkubellmio.GroupName, Scheme, ParameterCodec, Codecs)
+
+	clientCache, err := newClientCache(c.GenericConfig)
+	if err != nil {
+		return err
+	}
+	tunnels := tunnel.NewServer()
+	if err := s.installTunnelLeaderElection(c, tunnels); err != nil {
+		return err
+	}
+	clusterStorage, err := clusterstorage.NewStorage(Scheme, c.GenericConfig.RESTOptionsGetter, newSecretGetter(c.GenericConfig), clientCache, userGetter, tunnels)
+	if err != nil {
+		return err
+	}
+	remedyStorage, err := remedystorage.NewREST(Scheme, c.GenericConfig.RESTOptionsGetter)
+	if err != nil {
+		return err
+	}
+
+	v1alpha1storage := map[string]rest.Storage{}
+	v1alpha1storage[clusterkubellmio.ResourcePluralCluster] = clusterStorage.Cluster
+	v1alpha1storage[clusterkubellmio.ResourcePluralCluster+"/status"] = clusterStorage.Status
+	v1alpha1storage[clusterkubellmio.ResourcePluralCluster+"/proxy"] = clusterStorage.Proxy
+	v1alpha1storage[clusterkubellmio.ResourcePluralCluster+"/tunnel"] = clusterStorage.Tunnel
+	v1alpha1storage[clusterkubellmio.ResourcePluralRemedy] = remedyStorage
+	apiGroupInfo.VersionedResourcesStorageMap[clusterv1alpha1.SchemeGroupVersion.Version] = v1alpha1storage
+
+	return s.GenericAPIServer.InstallAPIGroup(&apiGroupInfo)
+}
+
+// installIAMAPIGroup 安装 iam.kubellm.io 组
+func (s *KubellmAPIServer) installIAMAPIGroup(userStorage *userstorage.UserStorage) error {
+	apiGroupInfo := genericapiserver.NewDefaultAPIGroupInfo(iamkubellmio.GroupName, Scheme, ParameterCodec, Codecs)
+
+	v1alpha1storage := map[string]rest.Storage{}
+	v1alpha1storage["users"] = userStorage.User
+	v1alpha1storage["users/status"] = userStorage.Status
+	v1alpha1storage["users/approve"] = userStorage.Approve
+	v1alpha1storage["users/reject"] = userStorage.Reject
+	apiGroupInfo.VersionedResourcesStorageMap[iamv1alpha1.SchemeGroupVersion.Version] = v1alpha1storage
+
+	return s.GenericAPIServer.InstallAPIGroup(&apiGroupInfo)
+}
+
+// installOAuth 安装本地用户的登录端点，并在启动后开始同步签名密钥，返回的令牌签发器也用于签发电子邮件验证令牌
+func (s *KubellmAPIServer) installOAuth(c completedConfig, credentials *userstorage.Credentials) (*token.Issuer, error) {
+	cfg := c.ExtraConfig.Token
+
+	// 没有核心集群的连接时签名密钥只保存在内存中，多副本之间无法互相校验令牌
+	var secrets corev1client.SecretsGetter
+	if c.ExtraConfig.KubeClient != nil {
+		secrets = c.ExtraConfig.KubeClient.CoreV1()
+	} else {
+		klog.Warning("No core cluster connection is configured, token signing keys are kept in memory only")
+	}
+	maxTokenTTL := max(cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
+	if c.ExtraConfig.Signup.autoApprove() {
+		maxTokenTTL = max(maxTokenTTL, c.ExtraConfig.Signup.EmailVerificationTTL)
+	}
+	keys := token.NewKeyManager(secrets, cfg.KeySecretNamespace, cfg.KeySecretName, cfg.KeyRotationPeriod, maxTokenTTL)
+	issuer := token.NewIssuer(keys, cfg.Issuer, cfg.Audiences, cfg.AccessTokenTTL, cfg.RefreshTokenTTL)
+	oauth.NewHandler(credentials, issuer, keys, cfg.Lockout, cfg.TrustedProxies).Install(s.GenericAPIServer.Handler.NonGoRestfulMux)
+
+	return issuer, s.GenericAPIServer.AddPostStartHook("start-kubellm-token-key-manager", func(hookContext genericapiserver.PostStartHookContext) error {
+		go keys.Run(hookContext)
+		return nil
+	})
+}
+
+// installSignup 在启用自注册时安装自注册端点，配置了自动审批的域名时同时安装验证链接的端点
+func (s *KubellmAPIServer) installSignup(c completedConfig, registration *userstorage.Registration, issuer *token.Issuer) error {
+	cfg := c.ExtraConfig.Signup
+	if !cfg.Enabled {
+		return nil
+	}
+	var verifier *signup.EmailVerifier
+	if cfg.autoApprove() {
+		mailer, err := signup.NewSMTPMailer(cfg.SMTP.Address, cfg.SMTP.From, cfg.SMTP.Username, cfg.SMTP.Password)
+		if err != nil {
+			return err
+		}
+		verifier = signup.NewEmailVerifier(cfg.AutoApproveEmailDomains, issuer, mailer, cfg.EmailVerificationTTL)
+	}
+	signup.NewHandler(registration, verifier, cfg.TrustedProxies, cfg.ClientRateLimit, cfg.RateLimit).
+		Install(s.GenericAPIServer.Handler.NonGoRestfulMux)
+	return nil
+}
+
+// newSecretGetter 基于核心集群的 Secret Lister 构造 Secret 读取函数
+func newSecretGetter(c genericapiserver.CompletedConfig) membercluster.SecretGetterFunc {
+	if c.SharedInformerFactory == nil {
+		return func(namespace, name string) (*corev1.Secret, error) {
+			return nil, fmt.Errorf("secret lister is not configured")
+		}
+	}
+	secretLister := c.SharedInformerFactory.Core().V1().Secrets().Lister()
+	return func(namespace, name string) (*corev1.Secret, error) {
+		return secretLister.Secrets(namespace).Get(name)
+	}
+}
+
+// newClientCache 创建 proxy 子资源使用的成员集群客户端缓存，Secret 中的凭证变化时清理对应的缓存项
+func newClientCache(c genericapiserver.CompletedConfig) (*membercluster.ClientCache, error) {
+	// 代理的请求可能是 watch 等长连接，不设置超时
+	clientCache := membercluster.NewClientCache(newSecretGetter(c), 0)
+	if c.SharedInformerFactory == nil {
+		return clientCache, nil
+	}
+	if _, err := c.SharedInformerFactory.Core().V1().Secrets().Informer().AddEventHandler(clientCache.SecretEventHandler()); err != nil {
+		return nil, err
+	}
+	return clientCache, nil
+}
+
+// newUserGetter 基于 User 存储构造按名称读取 User 的函数，直接读取存储而不经过鉴权
+func newUserGetter(store rest.Getter) clusterstorage.UserGetterFunc {
+	return func(ctx context.Context, name string) (*iamkubellmio.User, error) {
+		obj, err := store.Get(ctx, name, &metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.(*iamkubellmio.User), nil
+	}
+}
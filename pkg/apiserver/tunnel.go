@@ -0,0 +1,120 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+
+	"github.com/kubellm-io/kubellm/pkg/util/tunnel"
+)
+
+// installTunnelLeaderElection 在多个副本之间选出唯一维护隧道的活跃副本。
+// 隧道保存在接受它的副本的内存中，Service 可能把 agent 的隧道与 proxy 请求转发到任意副本，
+// 因此所有副本都报告就绪，不活跃的副本通过 relay 把它们转发给活跃副本。
+// 活跃副本的 relay 地址记录在 Lease 的 holderIdentity 中，其余副本在 Lease 过期后接替。
+func (s *KubellmAPIServer) installTunnelLeaderElection(c completedConfig, tunnels *tunnel.Server) error {
+	cfg := c.ExtraConfig.Tunnel.LeaderElection
+	if !cfg.LeaderElect {
+		return nil
+	}
+	// 没有核心集群的连接时无法选举，只能运行单个副本
+	if c.ExtraConfig.KubeClient == nil {
+		klog.Warning("No core cluster connection is configured, tunnels are served without leader election and only a single replica is supported")
+		return nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	relayAddress := net.JoinHostPort(c.GenericConfig.PublicAddress.String(), strconv.Itoa(c.ExtraConfig.Tunnel.RelayPort))
+	id := hostname + "_" + string(uuid.NewUUID()) + "_" + relayAddress
+	lock, err := resourcelock.New(cfg.ResourceLock, cfg.ResourceNamespace, cfg.ResourceName,
+		c.ExtraConfig.KubeClient.CoreV1(), c.ExtraConfig.KubeClient.CoordinationV1(), resourcelock.ResourceLockConfig{Identity: id})
+	if err != nil {
+		return fmt.Errorf("failed to create tunnel resource lock: %w", err)
+	}
+
+	tunnels.SetActive(false)
+	return s.GenericAPIServer.AddPostStartHook("start-kubellm-tunnel-leader-election", func(hookContext genericapiserver.PostStartHookContext) error {
+		listener, err := net.Listen("tcp", net.JoinHostPort(c.ExtraConfig.Tunnel.RelayBindAddress.String(), strconv.Itoa(c.ExtraConfig.Tunnel.RelayPort)))
+		if err != nil {
+			return fmt.Errorf("failed to listen for the tunnel relay: %w", err)
+		}
+
+		go func() {
+			// 所有副本共享 relay 的证书，核心集群暂时不可用时持续重试
+			var credentials *tunnel.RelayCredentials
+			err := wait.PollUntilContextCancel(hookContext, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+				var err error
+				credentials, err = tunnel.LoadRelayCredentials(ctx, c.ExtraConfig.KubeClient.CoreV1(), cfg.ResourceNamespace, cfg.ResourceName+"-relay")
+				if err != nil {
+					klog.ErrorS(err, "Failed to load tunnel relay credentials")
+					return false, nil
+				}
+				return true, nil
+			})
+			if err != nil {
+				listener.Close()
+				return
+			}
+			tunnels.EnableRelay(credentials)
+			go func() {
+				if err := tunnels.ServeRelay(hookContext, listener, credentials); err != nil {
+					klog.ErrorS(err, "Tunnel relay stopped")
+				}
+			}()
+
+			leaderelection.RunOrDie(hookContext, leaderelection.LeaderElectionConfig{
+				Lock:            lock,
+				LeaseDuration:   cfg.LeaseDuration.Duration,
+				RenewDeadline:   cfg.RenewDeadline.Duration,
+				RetryPeriod:     cfg.RetryPeriod.Duration,
+				ReleaseOnCancel: true,
+				Name:            cfg.ResourceName,
+				Callbacks: leaderelection.LeaderCallbacks{
+					OnStartedLeading: func(ctx context.Context) {
+						klog.InfoS("Became the active replica for tunnels", "identity", id)
+						tunnels.SetActive(true)
+					},
+					OnStoppedLeading: func() {
+						tunnels.SetActive(false)
+						// 失去 Lease 后立即退出，避免与新的活跃副本同时持有隧道
+						if hookContext.Err() == nil {
+							klog.InfoS("Lost the tunnel lease", "identity", id)
+							os.Exit(1)
+						}
+					},
+					OnNewLeader: func(identity string) {
+						if identity == id {
+							return
+						}
+						klog.InfoS("Relaying tunnels to the active replica", "identity", identity)
+						tunnels.SetLeader(leaderRelayAddress(identity))
+					},
+				},
+			})
+		}()
+		return nil
+	})
+}
+
+// leaderRelayAddress 从 Lease 的 holderIdentity 中解析活跃副本 relay 的地址，格式为 {hostname}_{uid}_{address}
+func leaderRelayAddress(identity string) string {
+	parts := strings.Split(identity, "_")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[len(parts)-1]
+}
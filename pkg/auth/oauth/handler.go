@@ -0,0 +1,292 @@
+package oauth
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apiserver/pkg/server/mux"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/auth/token"
+	userstorage "github.com/kubellm-io/kubellm/pkg/registry/iam/user"
+	"github.com/kubellm-io/kubellm/pkg/util/clientip"
+)
+
+const (
+	// TokenPath 是令牌端点，支持 password 与 refresh_token 两种授权方式
+	TokenPath = "/oauth/token"
+	// JWKSPath 发布校验令牌使用的公钥
+	JWKSPath = "/oauth/jwks"
+	// DiscoveryPath 是 OpenID Connect 发现文档，kube-apiserver 等验证方可以据此找到 JWKSPath
+	DiscoveryPath = "/.well-known/openid-configuration"
+)
+
+// Paths 是不需要认证与鉴权即可访问的路径，需要加入 kubellm-apiserver 的 AlwaysAllowPaths
+var Paths = []string{TokenPath, JWKSPath, DiscoveryPath}
+
+const (
+	grantTypePassword     = "password"
+	grantTypeRefreshToken = "refresh_token"
+)
+
+// RFC 6749 5.2 定义的错误码
+const (
+	errInvalidRequest       = "invalid_request"
+	errInvalidGrant         = "invalid_grant"
+	errUnsupportedGrantType = "unsupported_grant_type"
+	errServerError          = "server_error"
+)
+
+// Handler 提供本地用户的密码登录。
+// 这些端点不是聚合 API 的一部分，kube-apiserver 不会代理它们，需要通过 Service 或 Ingress 直接访问 kubellm-apiserver。
+type Handler struct {
+	credentials *userstorage.Credentials
+	issuer      *token.Issuer
+	keys        *token.KeyManager
+	lockout     userstorage.LockoutPolicy
+	// trustedProxies 是转发登录请求的反向代理的地址范围，只有来自它们的 X-Forwarded-For 会被采信
+	trustedProxies []*net.IPNet
+	clock          clock.Clock
+}
+
+// NewHandler 创建登录端点的处理器，lockout 决定连续登录失败多少次后暂时限制用户登录。
+// 请求经过 trustedProxies 中的反向代理转发时，记录的客户端 IP 取自 X-Forwarded-For。
+func NewHandler(credentials *userstorage.Credentials, issuer *token.Issuer, keys *token.KeyManager, lockout userstorage.LockoutPolicy, trustedProxies []*net.IPNet) *Handler {
+	return &Handler{
+		credentials:    credentials,
+		issuer:         issuer,
+		keys:           keys,
+		lockout:        lockout,
+		trustedProxies: trustedProxies,
+		clock:          clock.RealClock{},
+	}
+}
+
+// Install 将所有端点注册到 mux
+func (h *Handler) Install(mux *mux.PathRecorderMux) {
+	mux.HandleFunc(TokenPath, h.serveToken)
+	mux.HandleFunc(JWKSPath, h.serveJWKS)
+	mux.HandleFunc(DiscoveryPath, h.serveDiscovery)
+}
+
+// tokenResponse 是 RFC 6749 5.1 定义的成功响应
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// errorResponse 是 RFC 6749 5.2 定义的错误响应
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+func (h *Handler) serveToken(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, errInvalidRequest, "the token endpoint only accepts POST requests")
+		return
+	}
+	if err := req.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, errInvalidRequest, "failed to parse the request body")
+		return
+	}
+
+	var (
+		pair   *token.Pair
+		status int
+		code   string
+		err    error
+	)
+	switch grantType := req.PostForm.Get("grant_type"); grantType {
+	case grantTypePassword:
+		pair, status, code, err = h.passwordGrant(req)
+	case grantTypeRefreshToken:
+		pair, status, code, err = h.refreshTokenGrant(req)
+	case "":
+		writeError(w, http.StatusBadRequest, errInvalidRequest, "grant_type is required")
+		return
+	default:
+		writeError(w, http.StatusBadRequest, errUnsupportedGrantType, "unsupported grant_type "+grantType)
+		return
+	}
+	if err != nil {
+		if code == errServerError {
+			klog.ErrorS(err, "Failed to issue tokens")
+			writeError(w, status, code, "")
+			return
+		}
+		writeError(w, status, code, err.Error())
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	writeJSON(w, http.StatusOK, &tokenResponse{
+		AccessToken:  pair.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(pair.ExpiresIn.Seconds()),
+		RefreshToken: pair.RefreshToken,
+	})
+}
+
+// passwordGrant 校验用户名与密码并签发令牌，成功后记录最后登录时间、客户端 IP 与新的刷新令牌会话
+func (h *Handler) passwordGrant(req *http.Request) (*token.Pair, int, string, error) {
+	username, password := req.PostForm.Get("username"), req.PostForm.Get("password")
+	if len(username) == 0 || len(password) == 0 {
+		return nil, http.StatusBadRequest, errInvalidRequest, errors.New("username and password are required")
+	}
+
+	user, err := h.credentials.Authenticate(req.Context(), username, password)
+	if errors.Is(err, userstorage.ErrInvalidCredentials) {
+		h.recordFailure(req, username)
+	}
+	if errors.Is(err, userstorage.ErrInvalidCredentials) || errors.Is(err, userstorage.ErrLoginNotAllowed) {
+		klog.V(2).InfoS("Rejected password login", "user", username, "err", err)
+		return nil, http.StatusBadRequest, errInvalidGrant, err
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, errServerError, err
+	}
+
+	pair, err := h.issuer.Issue(user, string(uuid.NewUUID()))
+	if err != nil {
+		return nil, http.StatusInternalServerError, errServerError, err
+	}
+
+	clientIP := ""
+	if ip := clientip.SourceIP(req, h.trustedProxies); ip != nil {
+		clientIP = ip.String()
+	}
+	// 没有记录会话的刷新令牌无法使用，因此记录失败时本次登录失败
+	if err := h.credentials.RecordLogin(req.Context(), user.Name, clientIP, h.clock.Now(), refreshTokenSession(pair)); err != nil {
+		return nil, http.StatusInternalServerError, errServerError, err
+	}
+	klog.V(2).InfoS("User logged in", "user", user.Name, "clientIP", clientIP)
+	return pair, http.StatusOK, "", nil
+}
+
+// recordFailure 记录一次密码错误，用户不存在时忽略
+func (h *Handler) recordFailure(req *http.Request, username string) {
+	locked, err := h.credentials.RecordLoginFailure(req.Context(), username, h.clock.Now(), h.lockout)
+	if apierrors.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to record failed login", "user", username)
+		return
+	}
+	if locked {
+		klog.InfoS("User is blocked from logging in after too many failed attempts", "user", username)
+	}
+}
+
+// refreshTokenGrant 用刷新令牌换取新的访问令牌与刷新令牌。
+// 刷新时重新检查用户是否允许登录，并拒绝签发给同名的已删除用户或在修改密码之前签发的刷新令牌。
+// 刷新令牌只能使用一次，每次刷新都在同一个会话中轮换为新的刷新令牌；已经使用过的刷新令牌再次出现时撤销整个会话。
+func (h *Handler) refreshTokenGrant(req *http.Request) (*token.Pair, int, string, error) {
+	refreshToken := req.PostForm.Get("refresh_token")
+	if len(refreshToken) == 0 {
+		return nil, http.StatusBadRequest, errInvalidRequest, errors.New("refresh_token is required")
+	}
+	claims, err := h.issuer.VerifyRefreshToken(refreshToken)
+	if err != nil {
+		return nil, http.StatusBadRequest, errInvalidGrant, errors.New("invalid refresh token")
+	}
+
+	user, err := h.credentials.Get(req.Context(), claims.Subject)
+	if apierrors.IsNotFound(err) {
+		return nil, http.StatusBadRequest, errInvalidGrant, errors.New("invalid refresh token")
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, errServerError, err
+	}
+	if len(claims.UID) == 0 || claims.UID != string(user.UID) || len(claims.SessionID) == 0 || len(claims.ID) == 0 {
+		return nil, http.StatusBadRequest, errInvalidGrant, errors.New("invalid refresh token")
+	}
+	if err := userstorage.LoginAllowed(user); err != nil {
+		return nil, http.StatusBadRequest, errInvalidGrant, err
+	}
+	if changed := user.Status.PasswordLastChangedTime; changed != nil && claims.IssuedAt != nil && changed.After(claims.IssuedAt.Time) {
+		return nil, http.StatusBadRequest, errInvalidGrant, errors.New("the password has been changed since the refresh token was issued")
+	}
+
+	pair, err := h.issuer.Issue(user, claims.SessionID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errServerError, err
+	}
+	err = h.credentials.RotateRefreshToken(req.Context(), user.Name, claims.ID, refreshTokenSession(pair), h.clock.Now())
+	if errors.Is(err, userstorage.ErrRefreshTokenRevoked) {
+		klog.InfoS("Rejected refresh token", "user", user.Name, "session", claims.SessionID, "err", err)
+		return nil, http.StatusBadRequest, errInvalidGrant, err
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, errServerError, err
+	}
+	return pair, http.StatusOK, "", nil
+}
+
+// refreshTokenSession 返回记录 pair 中刷新令牌的会话
+func refreshTokenSession(pair *token.Pair) iamkubellmio.RefreshTokenSession {
+	return iamkubellmio.RefreshTokenSession{
+		ID:             pair.SessionID,
+		TokenID:        pair.RefreshTokenID,
+		ExpirationTime: metav1.NewTime(pair.RefreshExpiresAt),
+	}
+}
+
+func (h *Handler) serveJWKS(w http.ResponseWriter, req *http.Request) {
+	// 新密钥在开始签名前已经发布，验证方缓存的时间应短于该间隔
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	writeJSON(w, http.StatusOK, h.keys.JWKS())
+}
+
+// discovery 是 OpenID Connect Discovery 1.0 元数据中与令牌校验相关的部分
+type discovery struct {
+	Issuer                 string   `json:"issuer"`
+	TokenEndpoint          string   `json:"token_endpoint"`
+	JWKSURI                string   `json:"jwks_uri"`
+	GrantTypesSupported    []string `json:"grant_types_supported"`
+	ResponseTypesSupported []string `json:"response_types_supported"`
+	SubjectTypesSupported  []string `json:"subject_types_supported"`
+	SigningAlgsSupported   []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported        []string `json:"claims_supported"`
+}
+
+func (h *Handler) serveDiscovery(w http.ResponseWriter, req *http.Request) {
+	issuer := h.issuer.IssuerURL()
+	base := strings.TrimSuffix(issuer, "/")
+	writeJSON(w, http.StatusOK, &discovery{
+		Issuer:                 issuer,
+		TokenEndpoint:          base + TokenPath,
+		JWKSURI:                base + JWKSPath,
+		GrantTypesSupported:    []string{grantTypePassword, grantTypeRefreshToken},
+		ResponseTypesSupported: []string{"token"},
+		SubjectTypesSupported:  []string{"public"},
+		SigningAlgsSupported:   []string{"ES256"},
+		ClaimsSupported:        []string{"iss", "sub", "aud", "exp", "iat", "nbf", "jti", "sid", "uid", "email", "groups"},
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, code, description string) {
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, status, &errorResponse{Error: code, ErrorDescription: description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		klog.ErrorS(err, "Failed to write response")
+	}
+}
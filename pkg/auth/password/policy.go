@@ -0,0 +1,181 @@
+package password
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// MinLength 是任何策略都不能放宽的最小长度
+	MinLength = 8
+	// MaxLength 是明文密码的最大长度，bcrypt 只处理前 72 字节
+	MaxLength = 72
+)
+
+// CharacterClass 是密码中的一类字符
+type CharacterClass string
+
+const (
+	// Uppercase 是大写字母
+	Uppercase CharacterClass = "Uppercase"
+	// Lowercase 是小写字母
+	Lowercase CharacterClass = "Lowercase"
+	// Digit 是数字
+	Digit CharacterClass = "Digit"
+	// Symbol 是除字母与数字以外的可打印字符
+	Symbol CharacterClass = "Symbol"
+)
+
+var allCharacterClasses = []CharacterClass{Uppercase, Lowercase, Digit, Symbol}
+
+// Policy 是本地用户的密码策略，由 kubellm-apiserver 在创建与更新 User 时执行，
+// kubellm-controller 根据 MaxAge 计算密码的过期时间。两者应使用同一份配置文件。
+type Policy struct {
+	// MinLength 是密码的最小长度，不能小于 8
+	MinLength int `json:"minLength,omitempty"`
+	// RequiredCharacterClasses 是密码中必须包含的字符类别
+	RequiredCharacterClasses []CharacterClass `json:"requiredCharacterClasses,omitempty"`
+	// MinCharacterClasses 是密码中至少需要包含的不同字符类别的数量，取值 0 到 4
+	MinCharacterClasses int `json:"minCharacterClasses,omitempty"`
+	// ForbiddenPasswords 是禁止使用的密码，比较时忽略大小写
+	ForbiddenPasswords []string `json:"forbiddenPasswords,omitempty"`
+	// ForbiddenPasswordsFile 是每行一个禁止使用的密码的文件，与 ForbiddenPasswords 合并
+	ForbiddenPasswordsFile string `json:"forbiddenPasswordsFile,omitempty"`
+	// HistoryDepth 是修改密码时不能与之重复的最近密码数量（包括当前密码），0 表示不检查
+	HistoryDepth int `json:"historyDepth,omitempty"`
+	// MaxAge 是密码的最长使用时间，超过后用户进入 PasswordExpired 状态，0 表示永不过期
+	MaxAge metav1.Duration `json:"maxAge,omitempty"`
+
+	// forbidden 是 ForbiddenPasswords 与 ForbiddenPasswordsFile 合并后的小写集合
+	forbidden map[string]struct{}
+}
+
+// DefaultPolicy 返回没有配置文件时使用的策略，只要求最小长度，不检查历史，密码永不过期
+func DefaultPolicy() *Policy {
+	p := &Policy{MinLength: MinLength}
+	_ = p.complete()
+	return p
+}
+
+// LoadPolicy 从 YAML 或 JSON 文件加载密码策略，path 为空时返回 DefaultPolicy
+func LoadPolicy(path string) (*Policy, error) {
+	if len(path) == 0 {
+		return DefaultPolicy(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read password policy: %w", err)
+	}
+	p := &Policy{}
+	if err := yaml.UnmarshalStrict(data, p); err != nil {
+		return nil, fmt.Errorf("failed to decode password policy %s: %w", path, err)
+	}
+	if p.MinLength == 0 {
+		p.MinLength = MinLength
+	}
+	if err := p.validate(); err != nil {
+		return nil, fmt.Errorf("invalid password policy %s: %w", path, err)
+	}
+	if err := p.complete(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Policy) validate() error {
+	if p.MinLength < MinLength || p.MinLength > MaxLength {
+		return fmt.Errorf("minLength must be between %d and %d", MinLength, MaxLength)
+	}
+	for _, class := range p.RequiredCharacterClasses {
+		if !slices.Contains(allCharacterClasses, class) {
+			return fmt.Errorf("unknown character class %q, must be one of %v", class, allCharacterClasses)
+		}
+	}
+	if p.MinCharacterClasses < 0 || p.MinCharacterClasses > len(allCharacterClasses) {
+		return fmt.Errorf("minCharacterClasses must be between 0 and %d", len(allCharacterClasses))
+	}
+	if p.HistoryDepth < 0 {
+		return fmt.Errorf("historyDepth must not be negative")
+	}
+	if p.MaxAge.Duration < 0 {
+		return fmt.Errorf("maxAge must not be negative")
+	}
+	return nil
+}
+
+// complete 合并禁止使用的密码
+func (p *Policy) complete() error {
+	p.forbidden = make(map[string]struct{}, len(p.ForbiddenPasswords))
+	for _, forbidden := range p.ForbiddenPasswords {
+		p.forbidden[strings.ToLower(forbidden)] = struct{}{}
+	}
+	if len(p.ForbiddenPasswordsFile) == 0 {
+		return nil
+	}
+	data, err := os.ReadFile(p.ForbiddenPasswordsFile)
+	if err != nil {
+		return fmt.Errorf("failed to read forbidden passwords: %w", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			p.forbidden[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// Validate 校验明文密码是否满足长度、字符类别与禁用列表的要求，返回不满足的原因，错误信息中不包含密码本身。
+// 与历史密码的比较需要哈希，由调用方结合 HistoryDepth 完成。
+func (p *Policy) Validate(password string) []string {
+	var reasons []string
+	if len(password) < p.MinLength || len(password) > MaxLength {
+		reasons = append(reasons, fmt.Sprintf("must be between %d and %d characters", p.MinLength, MaxLength))
+	}
+
+	classes := characterClasses(password)
+	for _, class := range p.RequiredCharacterClasses {
+		if _, ok := classes[class]; !ok {
+			reasons = append(reasons, fmt.Sprintf("must contain at least one %s character", strings.ToLower(string(class))))
+		}
+	}
+	if len(classes) < p.MinCharacterClasses {
+		reasons = append(reasons, fmt.Sprintf("must contain at least %d of the character classes %v", p.MinCharacterClasses, allCharacterClasses))
+	}
+
+	if _, ok := p.forbidden[strings.ToLower(password)]; ok {
+		reasons = append(reasons, "is too common, choose a different password")
+	}
+	return reasons
+}
+
+// ExpiryTime 返回在 changed 时修改的密码的过期时间，策略没有设置 MaxAge 时返回 false
+func (p *Policy) ExpiryTime(changed time.Time) (time.Time, bool) {
+	if p.MaxAge.Duration <= 0 {
+		return time.Time{}, false
+	}
+	return changed.Add(p.MaxAge.Duration), true
+}
+
+func characterClasses(password string) map[CharacterClass]struct{} {
+	classes := make(map[CharacterClass]struct{}, len(allCharacterClasses))
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			classes[Uppercase] = struct{}{}
+		case unicode.IsLower(r):
+			classes[Lowercase] = struct{}{}
+		case unicode.IsDigit(r):
+			classes[Digit] = struct{}{}
+		case unicode.IsPrint(r) && !unicode.IsLetter(r):
+			classes[Symbol] = struct{}{}
+		}
+	}
+	return classes
+}
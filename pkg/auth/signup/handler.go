@@ -0,0 +1,221 @@
+package signup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apiserver/pkg/server/mux"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	userstorage "github.com/kubellm-io/kubellm/pkg/registry/iam/user"
+	"github.com/kubellm-io/kubellm/pkg/util/clientip"
+)
+
+// Path 是自注册端点
+const Path = "/signup"
+
+// Paths 是不需要认证与鉴权即可访问的路径，启用自注册时需要加入 kubellm-apiserver 的 AlwaysAllowPaths
+var Paths = []string{Path, VerifyPath}
+
+// maxRequestBytes 是注册请求体的最大长度
+const maxRequestBytes = 64 << 10
+
+// 错误响应中的错误码，格式与登录端点一致
+const (
+	errInvalidRequest = "invalid_request"
+	errInvalidToken   = "invalid_token"
+	errAccessDenied   = "access_denied"
+	errUserExists     = "user_exists"
+	errRateLimited    = "rate_limited"
+	errServerError    = "server_error"
+)
+
+// Request 是自注册请求，Username 即 User 的名称，也是登录名，必须是 DNS-1123 子域名
+type Request struct {
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+	Email       string `json:"email"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// Response 是自注册与验证电子邮件成功的响应。State 为 PendingApproval 时需要等待审批后才能登录，
+// VerificationEmailSent 为 true 时用户也可以打开验证邮件中的链接自动通过审批
+type Response struct {
+	Username              string                 `json:"username"`
+	State                 iamkubellmio.UserState `json:"state"`
+	VerificationEmailSent bool                   `json:"verificationEmailSent,omitempty"`
+}
+
+// errorResponse 是失败时的响应
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// Handler 提供本地用户的自注册。与登录端点一样，它不是聚合 API 的一部分，需要通过 Service 或 Ingress 直接访问 kubellm-apiserver。
+// 注册的用户不属于任何组，需要等待管理员审批；电子邮件域名在白名单中的用户验证电子邮件地址后自动通过审批。
+type Handler struct {
+	registration *userstorage.Registration
+	// verifier 为空时不自动审批
+	verifier *EmailVerifier
+	// trustedProxies 是转发请求的反向代理的地址范围，只有来自它们的 X-Forwarded-For 会被采信
+	trustedProxies []*net.IPNet
+	limiter        *rateLimiter
+	clock          clock.Clock
+}
+
+// NewHandler 创建自注册端点的处理器。每个客户端 IP 每小时最多注册 clientPerHour 次，所有客户端每分钟最多注册 perMinute 次。
+// 请求经过 trustedProxies 中的反向代理转发时，客户端 IP 取自 X-Forwarded-For。verifier 为空时不自动审批。
+func NewHandler(registration *userstorage.Registration, verifier *EmailVerifier, trustedProxies []*net.IPNet, clientPerHour, perMinute int) *Handler {
+	return &Handler{
+		registration:   registration,
+		verifier:       verifier,
+		trustedProxies: trustedProxies,
+		limiter:        newRateLimiter(clientPerHour, perMinute),
+		clock:          clock.RealClock{},
+	}
+}
+
+// Install 将端点注册到 mux
+func (h *Handler) Install(mux *mux.PathRecorderMux) {
+	mux.HandleFunc(Path, h.serveSignup)
+	if h.verifier != nil {
+		mux.HandleFunc(VerifyPath, h.serveVerify)
+	}
+}
+
+func (h *Handler) serveSignup(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, errInvalidRequest, "the signup endpoint only accepts POST requests")
+		return
+	}
+
+	clientIP := ""
+	if ip := clientip.SourceIP(req, h.trustedProxies); ip != nil {
+		clientIP = ip.String()
+	}
+	if ok, delay := h.limiter.allow(clientIP, h.clock.Now()); !ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		writeError(w, http.StatusTooManyRequests, errRateLimited, "too many signup requests, try again later")
+		return
+	}
+
+	signup := &Request{}
+	decoder := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(signup); err != nil {
+		writeError(w, http.StatusBadRequest, errInvalidRequest, "failed to decode the request body")
+		return
+	}
+	if len(signup.Username) == 0 || len(signup.Password) == 0 || len(signup.Email) == 0 {
+		writeError(w, http.StatusBadRequest, errInvalidRequest, "username, password and email are required")
+		return
+	}
+	// 管理员创建的 User 名称只需是合法的路径片段，自注册的用户名更严格：DNS-1123 子域名不包含 ':'，
+	// 因此无法注册 system: 开头或其他带有冒号、可能被误认为 Kubernetes 内置身份的名称
+	if msgs := validation.IsDNS1123Subdomain(signup.Username); len(msgs) > 0 {
+		writeError(w, http.StatusBadRequest, errInvalidRequest, fmt.Sprintf("invalid username %q: %s", signup.Username, strings.Join(msgs, "; ")))
+		return
+	}
+
+	user := &iamkubellmio.User{
+		ObjectMeta: metav1.ObjectMeta{Name: signup.Username},
+		Spec: iamkubellmio.UserSpec{
+			DisplayName: signup.DisplayName,
+			Email:       signup.Email,
+			Password:    signup.Password,
+		},
+	}
+	created, err := h.registration.Register(req.Context(), user, h.clock.Now())
+	switch {
+	case errors.Is(err, userstorage.ErrPasswordRequired):
+		writeError(w, http.StatusBadRequest, errInvalidRequest, err.Error())
+		return
+	case apierrors.IsAlreadyExists(err):
+		writeError(w, http.StatusConflict, errUserExists, fmt.Sprintf("username %q is already taken", signup.Username))
+		return
+	case apierrors.IsInvalid(err) || apierrors.IsBadRequest(err):
+		writeError(w, http.StatusUnprocessableEntity, errInvalidRequest, err.Error())
+		return
+	case err != nil:
+		klog.ErrorS(err, "Failed to register user", "user", signup.Username)
+		writeError(w, http.StatusInternalServerError, errServerError, "")
+		return
+	}
+
+	// 发送失败时用户仍然可以等待管理员审批，不影响注册结果
+	sent := false
+	if h.verifier != nil && h.verifier.allowed(created.Spec.Email) {
+		if err := h.verifier.send(created); err != nil {
+			klog.ErrorS(err, "Failed to send verification email", "user", created.Name)
+		} else {
+			sent = true
+		}
+	}
+
+	klog.InfoS("User registered", "user", created.Name, "state", created.Status.State, "verificationEmailSent", sent, "clientIP", clientIP)
+	writeJSON(w, http.StatusCreated, &Response{Username: created.Name, State: created.Status.State, VerificationEmailSent: sent})
+}
+
+// serveVerify 处理验证邮件中的链接：令牌有效、用户没有被重建或修改电子邮件地址、且域名仍在白名单中时自动通过审批
+func (h *Handler) serveVerify(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeError(w, http.StatusMethodNotAllowed, errInvalidRequest, "the verification endpoint only accepts GET requests")
+		return
+	}
+
+	claims, err := h.verifier.verify(req.URL.Query().Get("token"))
+	if err != nil {
+		klog.V(2).InfoS("Rejected email verification token", "err", err)
+		writeError(w, http.StatusBadRequest, errInvalidToken, "the verification link is invalid or expired")
+		return
+	}
+	if !h.verifier.allowed(claims.Email) {
+		writeError(w, http.StatusForbidden, errAccessDenied, "the email domain is not allowed to register without approval")
+		return
+	}
+
+	user, err := h.registration.AutoApprove(req.Context(), claims.Subject, types.UID(claims.UID), claims.Email, h.clock.Now())
+	switch {
+	case apierrors.IsNotFound(err) || errors.Is(err, userstorage.ErrVerificationStale):
+		writeError(w, http.StatusBadRequest, errInvalidToken, "the verification link is no longer valid")
+		return
+	case errors.Is(err, userstorage.ErrNotPendingApproval):
+		writeError(w, http.StatusConflict, errInvalidRequest, err.Error())
+		return
+	case err != nil:
+		klog.ErrorS(err, "Failed to approve user with verified email", "user", claims.Subject)
+		writeError(w, http.StatusInternalServerError, errServerError, "")
+		return
+	}
+
+	klog.InfoS("User verified email", "user", user.Name, "state", user.Status.State)
+	writeJSON(w, http.StatusOK, &Response{Username: user.Name, State: user.Status.State})
+}
+
+func writeError(w http.ResponseWriter, status int, code, description string) {
+	writeJSON(w, status, &errorResponse{Error: code, ErrorDescription: description})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		klog.ErrorS(err, "Failed to write response")
+	}
+}
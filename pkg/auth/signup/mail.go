@@ -0,0 +1,105 @@
+package signup
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+// smtpTimeout 是与 SMTP 服务器的一次会话的最长时间，发送验证邮件时注册请求会等待它完成
+const smtpTimeout = 10 * time.Second
+
+// Mailer 发送纯文本邮件
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+// SMTPMailer 通过 SMTP 服务器发送邮件，服务器支持 STARTTLS 时总是启用 TLS
+type SMTPMailer struct {
+	// address 是 SMTP 服务器的 host:port
+	address string
+	from    string
+	// auth 为空时不认证
+	auth smtp.Auth
+}
+
+var _ Mailer = &SMTPMailer{}
+
+// NewSMTPMailer 创建通过 address 的 SMTP 服务器以 from 的身份发送邮件的 Mailer，username 为空时不认证。
+// 认证使用 PLAIN 机制，net/smtp 只允许在 TLS 连接或本机上使用它。
+func NewSMTPMailer(address, from, username, password string) (*SMTPMailer, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP server address %q: %w", address, err)
+	}
+	m := &SMTPMailer{address: address, from: from}
+	if len(username) > 0 {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m, nil
+}
+
+// Send 发送一封邮件，to 必须是不带显示名称的电子邮件地址
+func (m *SMTPMailer) Send(to, subject, body string) error {
+	host, _, _ := net.SplitHostPort(m.address)
+	conn, err := net.DialTimeout("tcp", m.address, smtpTimeout)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(smtpTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if m.auth != nil {
+		if err := client.Auth(m.auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(m.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(m.message(to, subject, body)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// message 构造邮件内容，主题按 RFC 2047 编码
+func (m *SMTPMailer) message(to, subject, body string) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", m.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(body)
+	return buf.Bytes()
+}
@@ -0,0 +1,63 @@
+package signup
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/utils/lru"
+)
+
+// maxTrackedClients 是同时跟踪的客户端数量，超过后最久未出现的客户端的额度被丢弃
+const maxTrackedClients = 10000
+
+// rateLimiter 同时按客户端 IP 与全局限制注册请求的速率。
+// 客户端 IP 由 clientip.SourceIP 决定，全局限制保证请求来自大量不同的 IP 时注册速率仍然有上限。
+type rateLimiter struct {
+	global *rate.Limiter
+
+	// lock 保证同一客户端并发的首个请求使用同一个限速器
+	lock        sync.Mutex
+	clients     *lru.Cache
+	clientLimit rate.Limit
+	clientBurst int
+}
+
+// newRateLimiter 创建限速器，每个客户端每小时最多 clientPerHour 次，所有客户端每分钟最多 perMinute 次
+func newRateLimiter(clientPerHour, perMinute int) *rateLimiter {
+	return &rateLimiter{
+		global:      rate.NewLimiter(rate.Every(time.Minute/time.Duration(perMinute)), perMinute),
+		clients:     lru.New(maxTrackedClients),
+		clientLimit: rate.Every(time.Hour / time.Duration(clientPerHour)),
+		clientBurst: clientPerHour,
+	}
+}
+
+// allow 判断来自 clientIP 的请求是否允许处理，不允许时返回需要等待的时间
+func (l *rateLimiter) allow(clientIP string, now time.Time) (bool, time.Duration) {
+	client := l.clientLimiter(clientIP)
+	reservation := client.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	global := l.global.ReserveN(now, 1)
+	if delay := global.DelayFrom(now); delay > 0 {
+		global.CancelAt(now)
+		// 被全局限制拒绝的请求不占用客户端的额度
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+func (l *rateLimiter) clientLimiter(clientIP string) *rate.Limiter {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if limiter, ok := l.clients.Get(clientIP); ok {
+		return limiter.(*rate.Limiter)
+	}
+	limiter := rate.NewLimiter(l.clientLimit, l.clientBurst)
+	l.clients.Add(clientIP, limiter)
+	return limiter
+}
@@ -0,0 +1,92 @@
+package signup
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/auth/token"
+)
+
+// VerifyPath 是验证链接的路径，用户打开验证邮件中的链接后按电子邮件域名自动审批
+const VerifyPath = Path + "/verify"
+
+// verificationTokens 签发与校验电子邮件验证令牌，由 token.Issuer 实现
+type verificationTokens interface {
+	IssuerURL() string
+	IssueEmailVerification(user *iamkubellmio.User, ttl time.Duration) (string, error)
+	VerifyEmailVerificationToken(signed string) (*token.Claims, error)
+}
+
+// EmailVerifier 按电子邮件域名白名单自动审批自注册的用户。
+// 注册时填写的电子邮件地址没有经过验证，任何人都可以填写白名单中的地址，
+// 因此注册后向该地址发送带有签名令牌的验证链接，用户打开链接证明其拥有该地址后才通过审批。
+type EmailVerifier struct {
+	// domains 是小写的电子邮件域名
+	domains sets.Set[string]
+	tokens  verificationTokens
+	mailer  Mailer
+	// ttl 是验证链接的有效期
+	ttl time.Duration
+}
+
+// NewEmailVerifier 创建电子邮件验证器，域名在 domains 中的用户在验证电子邮件地址后自动通过审批
+func NewEmailVerifier(domains []string, issuer *token.Issuer, mailer Mailer, ttl time.Duration) *EmailVerifier {
+	return newEmailVerifier(domains, issuer, mailer, ttl)
+}
+
+func newEmailVerifier(domains []string, tokens verificationTokens, mailer Mailer, ttl time.Duration) *EmailVerifier {
+	set := sets.New[string]()
+	for _, domain := range domains {
+		set.Insert(strings.ToLower(domain))
+	}
+	return &EmailVerifier{domains: set, tokens: tokens, mailer: mailer, ttl: ttl}
+}
+
+// allowed 判断电子邮件地址的域名是否在自动审批的白名单中。
+// 地址格式错误时返回 false，创建 User 时的校验会拒绝它。
+func (v *EmailVerifier) allowed(email string) bool {
+	_, domain, ok := splitAddress(email)
+	return ok && v.domains.Has(domain)
+}
+
+// send 向 user 的电子邮件地址发送验证链接
+func (v *EmailVerifier) send(user *iamkubellmio.User) error {
+	address, _, ok := splitAddress(user.Spec.Email)
+	if !ok {
+		return fmt.Errorf("invalid email address %q", user.Spec.Email)
+	}
+	signed, err := v.tokens.IssueEmailVerification(user, v.ttl)
+	if err != nil {
+		return err
+	}
+	link := strings.TrimSuffix(v.tokens.IssuerURL(), "/") + VerifyPath + "?" + url.Values{"token": {signed}}.Encode()
+	body := fmt.Sprintf("Hello %s,\r\n\r\n"+
+		"Open the following link within %s to verify your email address and activate your kubellm account:\r\n\r\n"+
+		"%s\r\n\r\n"+
+		"If you did not sign up for kubellm, ignore this email.\r\n", user.Name, v.ttl, link)
+	return v.mailer.Send(address, "Verify your kubellm account", body)
+}
+
+// verify 校验验证链接中的令牌，返回其中的声明
+func (v *EmailVerifier) verify(signed string) (*token.Claims, error) {
+	return v.tokens.VerifyEmailVerificationToken(signed)
+}
+
+// splitAddress 解析电子邮件地址，返回不带显示名称的地址与小写的域名
+func splitAddress(email string) (string, string, bool) {
+	address, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", "", false
+	}
+	at := strings.LastIndex(address.Address, "@")
+	if at < 0 {
+		return "", "", false
+	}
+	return address.Address, strings.ToLower(address.Address[at+1:]), true
+}
@@ -0,0 +1,141 @@
+package signup
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/auth/token"
+)
+
+// fakeTokens 以 "<用户名>|<电子邮件地址>" 作为验证令牌
+type fakeTokens struct{}
+
+func (fakeTokens) IssuerURL() string { return "https://kubellm.example.com/" }
+
+func (fakeTokens) IssueEmailVerification(user *iamkubellmio.User, _ time.Duration) (string, error) {
+	return user.Name + "|" + user.Spec.Email, nil
+}
+
+func (fakeTokens) VerifyEmailVerificationToken(signed string) (*token.Claims, error) {
+	name, email, ok := strings.Cut(signed, "|")
+	if !ok {
+		return nil, errors.New("malformed token")
+	}
+	claims := &token.Claims{Email: email}
+	claims.Subject = name
+	return claims, nil
+}
+
+// fakeMailer 记录发送的邮件
+type fakeMailer struct {
+	to   string
+	body string
+}
+
+func (m *fakeMailer) Send(to, _, body string) error {
+	m.to, m.body = to, body
+	return nil
+}
+
+func TestAllowed(t *testing.T) {
+	verifier := newEmailVerifier([]string{"Example.com"}, fakeTokens{}, &fakeMailer{}, time.Hour)
+	tests := []struct {
+		email    string
+		expected bool
+	}{
+		{email: "alice@example.com", expected: true},
+		{email: "Alice <alice@EXAMPLE.com>", expected: true},
+		{email: "alice@sub.example.com", expected: false},
+		{email: "alice@example.com.evil.io", expected: false},
+		// 本地部分带有引号的 @ 时按最后一个 @ 取域名
+		{email: `"bob@example.com"@evil.io`, expected: false},
+		{email: "not-an-address", expected: false},
+	}
+	for _, tt := range tests {
+		if allowed := verifier.allowed(tt.email); allowed != tt.expected {
+			t.Errorf("allowed(%q) = %v, expected %v", tt.email, allowed, tt.expected)
+		}
+	}
+}
+
+func TestSend(t *testing.T) {
+	mailer := &fakeMailer{}
+	verifier := newEmailVerifier([]string{"example.com"}, fakeTokens{}, mailer, time.Hour)
+	user := &iamkubellmio.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+		Spec:       iamkubellmio.UserSpec{Email: "Alice <alice@example.com>"},
+	}
+	if err := verifier.send(user); err != nil {
+		t.Fatalf("failed to send verification email: %v", err)
+	}
+	// 邮件发送到不带显示名称的地址
+	if mailer.to != "alice@example.com" {
+		t.Errorf("expected the email to be sent to alice@example.com, got %q", mailer.to)
+	}
+	link := "https://kubellm.example.com" + VerifyPath + "?" + url.Values{"token": {"alice|Alice <alice@example.com>"}}.Encode()
+	if !strings.Contains(mailer.body, link) {
+		t.Errorf("expected the email to contain %q, got %q", link, mailer.body)
+	}
+}
+
+func TestServeVerify(t *testing.T) {
+	verifier := newEmailVerifier([]string{"example.com"}, fakeTokens{}, &fakeMailer{}, time.Hour)
+	handler := NewHandler(nil, verifier, nil, 5, 30)
+
+	tests := []struct {
+		name      string
+		method    string
+		token     string
+		status    int
+		errorCode string
+	}{
+		{
+			name:      "wrong method",
+			method:    http.MethodPost,
+			token:     "alice|alice@example.com",
+			status:    http.StatusMethodNotAllowed,
+			errorCode: errInvalidRequest,
+		},
+		{
+			name:      "invalid token",
+			method:    http.MethodGet,
+			token:     "garbage",
+			status:    http.StatusBadRequest,
+			errorCode: errInvalidToken,
+		},
+		{
+			// 域名在发送验证邮件后被移出白名单
+			name:      "domain no longer allowed",
+			method:    http.MethodGet,
+			token:     "mallory|mallory@evil.io",
+			status:    http.StatusForbidden,
+			errorCode: errAccessDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, VerifyPath+"?"+url.Values{"token": {tt.token}}.Encode(), nil)
+			rec := httptest.NewRecorder()
+			handler.serveVerify(rec, req)
+			if rec.Code != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, rec.Code)
+			}
+			resp := &errorResponse{}
+			if err := json.Unmarshal(rec.Body.Bytes(), resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Error != tt.errorCode {
+				t.Errorf("expected error %q, got %q", tt.errorCode, resp.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package token
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// keysSecretKey 是 Secret 中保存签名密钥列表的键
+	keysSecretKey = "keys.json"
+
+	// syncPeriod 是从 Secret 重新加载签名密钥并按需轮换的周期
+	syncPeriod = 30 * time.Second
+	// propagationDelay 是新密钥创建后开始用于签名前的等待时间，
+	// 保证所有 kubellm-apiserver 副本与 JWKS 的使用方在新密钥签发的令牌到达前已经加载了它
+	propagationDelay = 4 * syncPeriod
+)
+
+// signingKey 是一个 ES256 签名密钥
+type signingKey struct {
+	id      string
+	created time.Time
+	private *ecdsa.PrivateKey
+}
+
+// storedKey 是签名密钥在 Secret 中的序列化格式
+type storedKey struct {
+	ID         string    `json:"kid"`
+	Created    time.Time `json:"created"`
+	PrivateKey string    `json:"privateKey"`
+}
+
+// KeyManager 管理令牌的签名密钥。密钥保存在核心集群的 Secret 中，由所有 kubellm-apiserver 副本共享，
+// 每个副本周期性地重新加载 Secret，最新的密钥超过轮换周期后由任意一个副本生成新密钥并以乐观锁写回。
+// 旧密钥在其签发的令牌全部过期后才会被删除，因此轮换不会使已签发的令牌失效。
+type KeyManager struct {
+	// secrets 为空时密钥只保存在内存中，适用于单副本或测试环境，重启后已签发的令牌全部失效
+	secrets   corev1client.SecretsGetter
+	namespace string
+	name      string
+
+	rotationPeriod time.Duration
+	// maxTokenTTL 是签发的令牌中最长的有效期，决定了旧密钥的保留时间
+	maxTokenTTL time.Duration
+
+	clock clock.Clock
+
+	lock sync.RWMutex
+	// keys 按创建时间升序排列
+	keys []*signingKey
+}
+
+// NewKeyManager 创建签名密钥管理器，密钥保存在 namespace/name 的 Secret 中
+func NewKeyManager(secrets corev1client.SecretsGetter, namespace, name string, rotationPeriod, maxTokenTTL time.Duration) *KeyManager {
+	return &KeyManager{
+		secrets:        secrets,
+		namespace:      namespace,
+		name:           name,
+		rotationPeriod: rotationPeriod,
+		maxTokenTTL:    maxTokenTTL,
+		clock:          clock.RealClock{},
+	}
+}
+
+// Run 周期性地同步与轮换签名密钥，直到 ctx 结束
+func (m *KeyManager) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := m.sync(ctx); err != nil {
+			logger.Error(err, "Failed to sync token signing keys", "secret", klog.KRef(m.namespace, m.name))
+		}
+	}, syncPeriod)
+}
+
+// sync 加载签名密钥，按需生成新密钥并清理过期的密钥
+func (m *KeyManager) sync(ctx context.Context) error {
+	if m.secrets == nil {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		keys, _, err := m.rotate(m.keys)
+		if err != nil {
+			return err
+		}
+		m.keys = keys
+		return nil
+	}
+
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		secret, err := m.secrets.Secrets(m.namespace).Get(ctx, m.name, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		notFound := apierrors.IsNotFound(err)
+
+		var keys []*signingKey
+		if !notFound {
+			if keys, err = decodeKeys(secret.Data[keysSecretKey]); err != nil {
+				return fmt.Errorf("failed to decode signing keys from secret %s/%s: %w", m.namespace, m.name, err)
+			}
+		}
+		keys, changed, err := m.rotate(keys)
+		if err != nil {
+			return err
+		}
+		if changed {
+			data, err := encodeKeys(keys)
+			if err != nil {
+				return err
+			}
+			if notFound {
+				secret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: m.namespace, Name: m.name},
+					Type:       corev1.SecretTypeOpaque,
+				}
+			}
+			secret.Data = map[string][]byte{keysSecretKey: data}
+			if notFound {
+				_, err = m.secrets.Secrets(m.namespace).Create(ctx, secret, metav1.CreateOptions{})
+			} else {
+				_, err = m.secrets.Secrets(m.namespace).Update(ctx, secret, metav1.UpdateOptions{})
+			}
+			if err != nil {
+				return err
+			}
+			klog.FromContext(ctx).Info("Rotated token signing keys", "secret", klog.KRef(m.namespace, m.name), "keys", len(keys))
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.keys = keys
+		return nil
+	})
+}
+
+// rotate 在最新的密钥超过轮换周期时生成新密钥，并删除签发的令牌已全部过期的旧密钥，返回的 keys 按创建时间升序排列
+func (m *KeyManager) rotate(keys []*signingKey) ([]*signingKey, bool, error) {
+	now := m.clock.Now()
+	keys = slices.Clone(keys)
+	slices.SortFunc(keys, func(a, b *signingKey) int {
+		return a.created.Compare(b.created)
+	})
+
+	changed := false
+	if len(keys) == 0 || now.Sub(keys[len(keys)-1].created) >= m.rotationPeriod {
+		key, err := newSigningKey(now)
+		if err != nil {
+			return nil, false, err
+		}
+		keys = append(keys, key)
+		changed = true
+	}
+
+	// 一个密钥在下一个密钥开始签名后不再使用，再经过 maxTokenTTL 后它签发的令牌全部过期
+	retained := make([]*signingKey, 0, len(keys))
+	for i, key := range keys {
+		if i < len(keys)-1 && now.After(keys[i+1].created.Add(propagationDelay+m.maxTokenTTL)) {
+			changed = true
+			continue
+		}
+		retained = append(retained, key)
+	}
+	return retained, changed, nil
+}
+
+// signingKey 返回当前用于签名的密钥：已创建超过 propagationDelay 的最新密钥，
+// 只有刚创建的密钥时（首次启动）直接使用它
+func (m *KeyManager) signingKey() (*signingKey, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if len(m.keys) == 0 {
+		return nil, fmt.Errorf("token signing keys are not loaded yet")
+	}
+	now := m.clock.Now()
+	for i := len(m.keys) - 1; i >= 0; i-- {
+		if now.Sub(m.keys[i].created) >= propagationDelay {
+			return m.keys[i], nil
+		}
+	}
+	return m.keys[len(m.keys)-1], nil
+}
+
+// publicKey 返回 kid 对应的公钥
+func (m *KeyManager) publicKey(kid string) (*ecdsa.PublicKey, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	for _, key := range m.keys {
+		if key.id == kid {
+			return &key.private.PublicKey, true
+		}
+	}
+	return nil, false
+}
+
+// JSONWebKey 是 RFC 7517 定义的 EC 公钥
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
+// JSONWebKeySet 是 JWKS 端点返回的公钥集合
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS 返回所有未删除的签名密钥对应的公钥，包括尚未开始签名的新密钥，使验证方可以提前缓存
+func (m *KeyManager) JWKS() JSONWebKeySet {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(m.keys))}
+	for _, key := range m.keys {
+		public := key.private.PublicKey
+		set.Keys = append(set.Keys, JSONWebKey{
+			KeyType:   "EC",
+			Curve:     "P-256",
+			X:         base64.RawURLEncoding.EncodeToString(public.X.FillBytes(make([]byte, 32))),
+			Y:         base64.RawURLEncoding.EncodeToString(public.Y.FillBytes(make([]byte, 32))),
+			KeyID:     key.id,
+			Use:       "sig",
+			Algorithm: "ES256",
+		})
+	}
+	return set
+}
+
+// newSigningKey 生成一个 P-256 密钥，kid 为公钥 SubjectPublicKeyInfo 的 SHA-256 摘要
+func newSigningKey(created time.Time) (*signingKey, error) {
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	id, err := keyID(&private.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &signingKey{id: id, created: created.UTC().Truncate(time.Second), private: private}, nil
+}
+
+func keyID(public *ecdsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func encodeKeys(keys []*signingKey) ([]byte, error) {
+	stored := make([]storedKey, 0, len(keys))
+	for _, key := range keys {
+		der, err := x509.MarshalPKCS8PrivateKey(key.private)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal signing key %s: %w", key.id, err)
+		}
+		stored = append(stored, storedKey{
+			ID:         key.id,
+			Created:    key.created,
+			PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		})
+	}
+	return json.Marshal(stored)
+}
+
+func decodeKeys(data []byte) ([]*signingKey, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var stored []storedKey
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+	keys := make([]*signingKey, 0, len(stored))
+	for _, s := range stored {
+		block, _ := pem.Decode([]byte(s.PrivateKey))
+		if block == nil {
+			return nil, fmt.Errorf("signing key %s is not PEM encoded", s.ID)
+		}
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signing key %s: %w", s.ID, err)
+		}
+		private, ok := parsed.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("signing key %s is not an ECDSA key", s.ID)
+		}
+		keys = append(keys, &signingKey{id: s.ID, created: s.Created, private: private})
+	}
+	return keys, nil
+}
@@ -0,0 +1,173 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/utils/clock"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+const (
+	// UseAccess 标识访问令牌，用于访问 API
+	UseAccess = "access"
+	// UseRefresh 标识刷新令牌，只能在令牌端点换取新的令牌
+	UseRefresh = "refresh"
+	// UseEmailVerification 标识电子邮件验证令牌，只能用于自注册的验证链接
+	UseEmailVerification = "email-verification"
+)
+
+// Claims 是 kubellm 签发的令牌中的声明，sub 为用户名
+type Claims struct {
+	jwt.RegisteredClaims
+	// UID 是 User 的 metadata.uid，用户被删除后以同名重建时 UID 不同，旧的刷新令牌因此失效
+	UID string `json:"uid"`
+	// TokenUse 区分访问令牌与刷新令牌，避免刷新令牌被当作访问令牌使用
+	TokenUse string `json:"token_use"`
+	// SessionID 是签发令牌的登录会话，同一次登录以及之后每次刷新签发的令牌相同
+	SessionID string `json:"sid,omitempty"`
+	// Email 是用户的电子邮件地址
+	Email string `json:"email,omitempty"`
+	// Groups 是用户所属的组
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Pair 是一次登录或刷新签发的令牌
+type Pair struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresIn 是访问令牌的有效期
+	ExpiresIn time.Duration
+	// SessionID 是签发令牌的登录会话
+	SessionID string
+	// RefreshTokenID 与 RefreshExpiresAt 是刷新令牌的 jti 与过期时间，用于记录会话中当前有效的刷新令牌
+	RefreshTokenID   string
+	RefreshExpiresAt time.Time
+}
+
+// Issuer 使用 KeyManager 当前的签名密钥签发与校验 ES256 令牌。
+// 访问令牌的 aud 为配置的 audiences，刷新令牌的 aud 为 issuer 本身，只能由 kubellm-apiserver 使用。
+type Issuer struct {
+	keys       *KeyManager
+	issuer     string
+	audiences  []string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	clock      clock.Clock
+}
+
+// NewIssuer 创建令牌签发器
+func NewIssuer(keys *KeyManager, issuer string, audiences []string, accessTTL, refreshTTL time.Duration) *Issuer {
+	return &Issuer{
+		keys:       keys,
+		issuer:     issuer,
+		audiences:  audiences,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+		clock:      clock.RealClock{},
+	}
+}
+
+// IssuerURL 返回令牌的 iss
+func (i *Issuer) IssuerURL() string {
+	return i.issuer
+}
+
+// Issue 在登录会话 sessionID 中为 user 签发访问令牌与刷新令牌
+func (i *Issuer) Issue(user *iamkubellmio.User, sessionID string) (*Pair, error) {
+	key, err := i.keys.signingKey()
+	if err != nil {
+		return nil, err
+	}
+	now := i.clock.Now()
+	access, _, err := i.sign(key, user, sessionID, UseAccess, i.audiences, now, i.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	refresh, refreshClaims, err := i.sign(key, user, sessionID, UseRefresh, []string{i.issuer}, now, i.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &Pair{
+		AccessToken:      access,
+		RefreshToken:     refresh,
+		ExpiresIn:        i.accessTTL,
+		SessionID:        sessionID,
+		RefreshTokenID:   refreshClaims.ID,
+		RefreshExpiresAt: refreshClaims.ExpiresAt.Time,
+	}, nil
+}
+
+func (i *Issuer) sign(key *signingKey, user *iamkubellmio.User, sessionID, use string, audiences []string, now time.Time, ttl time.Duration) (string, *Claims, error) {
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.issuer,
+			Subject:   user.Name,
+			Audience:  audiences,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			ID:        string(uuid.NewUUID()),
+		},
+		UID:       string(user.UID),
+		TokenUse:  use,
+		SessionID: sessionID,
+		Email:     user.Spec.Email,
+		Groups:    user.Spec.Groups,
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	t.Header["kid"] = key.id
+	signed, err := t.SignedString(key.private)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to sign %s token: %w", use, err)
+	}
+	return signed, claims, nil
+}
+
+// IssueEmailVerification 为 user 签发有效期为 ttl 的电子邮件验证令牌，aud 为 issuer 本身，email 为 user 当前的电子邮件地址
+func (i *Issuer) IssueEmailVerification(user *iamkubellmio.User, ttl time.Duration) (string, error) {
+	key, err := i.keys.signingKey()
+	if err != nil {
+		return "", err
+	}
+	signed, _, err := i.sign(key, user, "", UseEmailVerification, []string{i.issuer}, i.clock.Now(), ttl)
+	return signed, err
+}
+
+// VerifyEmailVerificationToken 校验电子邮件验证令牌的签名、有效期、iss 与 aud，返回其中的声明
+func (i *Issuer) VerifyEmailVerificationToken(signed string) (*Claims, error) {
+	return i.verify(signed, UseEmailVerification, i.issuer)
+}
+
+// VerifyRefreshToken 校验刷新令牌的签名、有效期、iss 与 aud，返回其中的声明
+func (i *Issuer) VerifyRefreshToken(refreshToken string) (*Claims, error) {
+	return i.verify(refreshToken, UseRefresh, i.issuer)
+}
+
+func (i *Issuer) verify(signed, use, audience string) (*Claims, error) {
+	claims := &Claims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}))
+	if _, err := parser.ParseWithClaims(signed, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := i.keys.publicKey(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return key, nil
+	}); err != nil {
+		return nil, err
+	}
+	if !claims.VerifyIssuer(i.issuer, true) {
+		return nil, fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	if !claims.VerifyAudience(audience, true) {
+		return nil, fmt.Errorf("token is not issued for audience %q", audience)
+	}
+	if claims.TokenUse != use {
+		return nil, fmt.Errorf("expected a %s token, got %q", use, claims.TokenUse)
+	}
+	return claims, nil
+}
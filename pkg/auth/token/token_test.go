@@ -0,0 +1,55 @@
+package token
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+func TestEmailVerificationToken(t *testing.T) {
+	keys := NewKeyManager(nil, "", "", time.Hour, 24*time.Hour)
+	if err := keys.sync(context.Background()); err != nil {
+		t.Fatalf("failed to generate signing key: %v", err)
+	}
+	issuer := NewIssuer(keys, "https://kubellm.example.com", []string{"kubellm"}, time.Minute, time.Hour)
+	user := &iamkubellmio.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice", UID: "alice-uid"},
+		Spec:       iamkubellmio.UserSpec{Email: "alice@example.com"},
+	}
+
+	signed, err := issuer.IssueEmailVerification(user, time.Hour)
+	if err != nil {
+		t.Fatalf("failed to issue verification token: %v", err)
+	}
+	claims, err := issuer.VerifyEmailVerificationToken(signed)
+	if err != nil {
+		t.Fatalf("failed to verify verification token: %v", err)
+	}
+	if claims.Subject != "alice" || claims.UID != "alice-uid" || claims.Email != "alice@example.com" {
+		t.Errorf("unexpected claims: %+v", claims)
+	}
+
+	// 验证令牌与刷新令牌的 aud 相同，只能通过 token_use 区分，二者不能互换
+	if _, err := issuer.VerifyRefreshToken(signed); err == nil {
+		t.Errorf("expected a verification token to be rejected as a refresh token")
+	}
+	pair, err := issuer.Issue(user, "session")
+	if err != nil {
+		t.Fatalf("failed to issue tokens: %v", err)
+	}
+	if _, err := issuer.VerifyEmailVerificationToken(pair.RefreshToken); err == nil {
+		t.Errorf("expected a refresh token to be rejected as a verification token")
+	}
+
+	expired, err := issuer.IssueEmailVerification(user, -time.Minute)
+	if err != nil {
+		t.Fatalf("failed to issue verification token: %v", err)
+	}
+	if _, err := issuer.VerifyEmailVerificationToken(expired); err == nil {
+		t.Errorf("expected an expired verification token to be rejected")
+	}
+}
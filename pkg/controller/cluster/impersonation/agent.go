@@ -0,0 +1,121 @@
+package impersonation
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	iaminformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/iam.kubellm.io/v1alpha1"
+	iamlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/iam.kubellm.io/v1alpha1"
+)
+
+// AgentControllerName 是 kubellm-agent 中维护 impersonator 的控制器名称
+const AgentControllerName = "agent-impersonation-controller"
+
+// agentQueueKey 是 AgentController 队列中唯一的 key，任何 User 的变化都重新同步整个 ClusterRole
+const agentQueueKey = ImpersonatorName
+
+// AgentController 由 Pull 模式成员集群中的 kubellm-agent 运行。控制面无法直接访问 Pull 模式的集群，
+// 因此由 agent 根据控制面中的 User 在所在集群中安装 impersonator，权限与 Push 模式相同，
+// agent 使用 impersonator 的凭证转发隧道中的请求，见 ImpersonatorConfig。
+type AgentController struct {
+	memberClient kubernetes.Interface
+	userLister   iamlisters.UserLister
+	userSynced   cache.InformerSynced
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewAgentController 创建 agent 侧的 impersonation 控制器，memberClient 是 agent 访问所在成员集群的客户端
+func NewAgentController(memberClient kubernetes.Interface, userInformer iaminformers.UserInformer) (*AgentController, error) {
+	c := &AgentController{
+		memberClient: memberClient,
+		userLister:   userInformer.Lister(),
+		userSynced:   userInformer.Informer().HasSynced,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: AgentControllerName},
+		),
+	}
+
+	enqueue := func(interface{}) { c.queue.Add(agentQueueKey) }
+	_, err := userInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    enqueue,
+		UpdateFunc: func(_, newObj interface{}) { enqueue(newObj) },
+		DeleteFunc: enqueue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run 启动控制器，直到 ctx 结束。只有一个 key，因此只需要一个 worker。
+func (c *AgentController) Run(ctx context.Context) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting controller", "controller", AgentControllerName)
+	defer logger.Info("Shutting down controller", "controller", AgentControllerName)
+
+	if !cache.WaitForNamedCacheSync(AgentControllerName, ctx.Done(), c.userSynced) {
+		return
+	}
+	// 没有任何 User 时也需要安装 impersonator，token 用于转发隧道中的请求
+	c.queue.Add(agentQueueKey)
+
+	go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	<-ctx.Done()
+}
+
+func (c *AgentController) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *AgentController) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.sync(ctx); err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "Error syncing impersonator")
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+// sync 按当前的 User 在成员集群中安装或更新 impersonator
+func (c *AgentController) sync(ctx context.Context) error {
+	users, err := c.userLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	return ensureImpersonator(ctx, c.memberClient, impersonatorRules(users))
+}
+
+// ImpersonatorConfig 返回以 impersonator 身份访问成员集群的配置。
+// config 是 agent 自身访问成员集群的配置，结果只保留其中的地址与 TLS 配置，凭证替换为 impersonator 的 token，
+// 该身份只能模拟 kubellm 中的用户与组，因此隧道中的请求无法借用 agent 自身的权限。
+func ImpersonatorConfig(ctx context.Context, client kubernetes.Interface, config *rest.Config) (*rest.Config, error) {
+	token, err := getImpersonatorToken(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	impersonatorConfig := rest.AnonymousClientConfig(config)
+	impersonatorConfig.BearerToken = string(token)
+	return impersonatorConfig, nil
+}
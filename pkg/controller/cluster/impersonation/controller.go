@@ -0,0 +1,290 @@
+package impersonation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	clusterinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/cluster.kubellm.io/v1alpha1"
+	iaminformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/iam.kubellm.io/v1alpha1"
+	clusterlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/cluster.kubellm.io/v1alpha1"
+	iamlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/iam.kubellm.io/v1alpha1"
+)
+
+// ControllerName 是集群 impersonation 控制器的名称
+const ControllerName = "cluster-impersonation-controller"
+
+// ClientBuilderFunc 根据 Cluster 构造访问成员集群的客户端
+type ClientBuilderFunc func(cluster *clusterv1alpha1.Cluster) (kubernetes.Interface, error)
+
+// Controller 在每个 Push 模式的成员集群中安装 impersonator 的 ServiceAccount 与 ClusterRole，
+// 把它的 token 保存到控制面的 Secret 中并写入 Cluster 的 spec.impersonatorSecretRef。
+// ClusterRole 只允许模拟 kubellm 中存在的用户及其所属的组，User 变化时会同步到所有成员集群。
+type Controller struct {
+	client        versioned.Interface
+	kubeClient    kubernetes.Interface
+	clusterLister clusterlisters.ClusterLister
+	clusterSynced cache.InformerSynced
+	userLister    iamlisters.UserLister
+	userSynced    cache.InformerSynced
+
+	// clientBuilder 用于构造成员集群的客户端
+	clientBuilder ClientBuilderFunc
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewController 创建集群 impersonation 控制器，kubeClient 用于在控制面中保存 impersonator 的 token
+func NewController(
+	client versioned.Interface,
+	kubeClient kubernetes.Interface,
+	clusterInformer clusterinformers.ClusterInformer,
+	userInformer iaminformers.UserInformer,
+	clientBuilder ClientBuilderFunc,
+) (*Controller, error) {
+	c := &Controller{
+		client:        client,
+		kubeClient:    kubeClient,
+		clusterLister: clusterInformer.Lister(),
+		clusterSynced: clusterInformer.Informer().HasSynced,
+		userLister:    userInformer.Lister(),
+		userSynced:    userInformer.Informer().HasSynced,
+		clientBuilder: clientBuilder,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: ControllerName},
+		),
+	}
+
+	_, err := clusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldCluster := oldObj.(*clusterv1alpha1.Cluster)
+			newCluster := newObj.(*clusterv1alpha1.Cluster)
+			// 只关心 Spec 与集群就绪状态的变化
+			if oldCluster.Generation != newCluster.Generation || isClusterReady(oldCluster) != isClusterReady(newCluster) {
+				c.enqueue(newObj)
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = userInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) { c.enqueueAllClusters() },
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldUser := oldObj.(*iamv1alpha1.User)
+			newUser := newObj.(*iamv1alpha1.User)
+			if !apiequality.Semantic.DeepEqual(oldUser.Spec.Groups, newUser.Spec.Groups) {
+				c.enqueueAllClusters()
+			}
+		},
+		DeleteFunc: func(interface{}) { c.enqueueAllClusters() },
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// enqueueAllClusters 在用户或其所属的组变化时重新同步所有集群的 ClusterRole
+func (c *Controller) enqueueAllClusters() {
+	clusters, err := c.clusterLister.List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, cluster := range clusters {
+		c.queue.Add(cluster.Name)
+	}
+}
+
+// Run 启动 workers 个协程处理队列，直到 ctx 结束
+func (c *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting controller", "controller", ControllerName)
+	defer logger.Info("Shutting down controller", "controller", ControllerName)
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), c.clusterSynced, c.userSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (c *Controller) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncCluster(ctx, key); err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "Error syncing cluster impersonator", "cluster", key)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+// syncCluster 在成员集群中安装 impersonator，并把它的 token 同步到控制面
+func (c *Controller) syncCluster(ctx context.Context, name string) error {
+	cluster, err := c.clusterLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// Pull 模式的集群由其中的 kubellm-agent 安装 impersonator 并以它的身份转发请求，见 AgentController；没有 SecretRef 的集群无法确定 Secret 的存放位置；
+	// 未就绪的集群等 Ready 条件变化后再处理
+	if !cluster.DeletionTimestamp.IsZero() || cluster.Spec.SyncMode == clusterv1alpha1.Pull ||
+		cluster.Spec.SecretRef == nil || !isClusterReady(cluster) {
+		return nil
+	}
+
+	users, err := c.userLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	memberClient, err := c.clientBuilder(cluster)
+	if err != nil {
+		return err
+	}
+	if err := ensureImpersonator(ctx, memberClient, impersonatorRules(users)); err != nil {
+		return err
+	}
+
+	// 管理员自行指定的 impersonator Secret 不做修改，只维护成员集群中的权限
+	secretRef := &clusterv1alpha1.LocalSecretReference{
+		Namespace: cluster.Spec.SecretRef.Namespace,
+		Name:      fmt.Sprintf("%s-impersonator", cluster.Name),
+	}
+	if cluster.Spec.ImpersonatorSecretRef != nil && *cluster.Spec.ImpersonatorSecretRef != *secretRef {
+		return nil
+	}
+
+	token, err := getImpersonatorToken(ctx, memberClient)
+	if err != nil {
+		return err
+	}
+	if err := c.ensureImpersonatorSecret(ctx, cluster, secretRef, token); err != nil {
+		return err
+	}
+	return c.updateImpersonatorSecretRef(ctx, cluster, secretRef)
+}
+
+// ensureImpersonatorSecret 在控制面中创建或更新保存 impersonator token 的 Secret，
+// Secret 的 owner 为对应的 Cluster，Cluster 删除后随之被回收
+func (c *Controller) ensureImpersonatorSecret(ctx context.Context, cluster *clusterv1alpha1.Cluster, ref *clusterv1alpha1.LocalSecretReference, token []byte) error {
+	existing, err := c.kubeClient.CoreV1().Secrets(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ref.Namespace,
+				Name:      ref.Name,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(cluster, clusterv1alpha1.SchemeGroupVersion.WithKind(clusterv1alpha1.ResourceKindCluster)),
+				},
+			},
+			Data: map[string][]byte{clusterv1alpha1.SecretTokenKey: token},
+		}
+		if _, err := c.kubeClient.CoreV1().Secrets(ref.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		klog.FromContext(ctx).Info("Created impersonator secret", "cluster", cluster.Name, "secret", klog.KRef(ref.Namespace, ref.Name))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	if bytes.Equal(existing.Data[clusterv1alpha1.SecretTokenKey], token) {
+		return nil
+	}
+
+	secret := existing.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[clusterv1alpha1.SecretTokenKey] = token
+	if _, err := c.kubeClient.CoreV1().Secrets(ref.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	klog.FromContext(ctx).Info("Updated impersonator secret", "cluster", cluster.Name, "secret", klog.KRef(ref.Namespace, ref.Name))
+	return nil
+}
+
+// updateImpersonatorSecretRef 在 Cluster 尚未引用 impersonator Secret 时写入 spec.impersonatorSecretRef
+func (c *Controller) updateImpersonatorSecretRef(ctx context.Context, cluster *clusterv1alpha1.Cluster, ref *clusterv1alpha1.LocalSecretReference) error {
+	if cluster.Spec.ImpersonatorSecretRef != nil {
+		return nil
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster = cluster.DeepCopy()
+		cluster.Spec.ImpersonatorSecretRef = ref.DeepCopy()
+		_, updateErr := c.client.ClusterV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		if updateErr == nil || !apierrors.IsConflict(updateErr) {
+			return updateErr
+		}
+		latest, err := c.client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if latest.Spec.ImpersonatorSecretRef != nil {
+			// 其他人已经设置，保留其设置
+			return nil
+		}
+		cluster = latest
+		return updateErr
+	})
+	if err != nil {
+		return err
+	}
+	klog.FromContext(ctx).Info("Set impersonator secret of cluster", "cluster", cluster.Name, "secret", klog.KRef(ref.Namespace, ref.Name))
+	return nil
+}
+
+func isClusterReady(cluster *clusterv1alpha1.Cluster) bool {
+	return meta.IsStatusConditionTrue(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady)
+}
@@ -0,0 +1,166 @@
+package impersonation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+)
+
+const (
+	// ImpersonatorNamespace 是成员集群中存放 impersonator ServiceAccount 的命名空间
+	ImpersonatorNamespace = "kubellm-cluster"
+	// ImpersonatorName 是成员集群中 impersonator 的 ServiceAccount、ClusterRole 与 ClusterRoleBinding 的名称
+	ImpersonatorName = "kubellm-impersonator"
+	// impersonatorTokenSecretName 是成员集群中保存 impersonator token 的 Secret 名称
+	impersonatorTokenSecretName = ImpersonatorName + "-token"
+)
+
+// impersonatorRules 返回 impersonator 需要的权限：只允许模拟 kubellm 中存在的用户及其所属的组。
+// 以 system: 开头的用户与组（例如 system:masters）即使出现在 kubellm 中也不会授权模拟。
+// resourceNames 为空的规则会匹配所有名称，因此没有用户或组时不生成对应的规则。
+func impersonatorRules(users []*iamv1alpha1.User) []rbacv1.PolicyRule {
+	names := sets.New[string]()
+	groups := sets.New[string]()
+	for _, user := range users {
+		if !strings.HasPrefix(user.Name, iamv1alpha1.SystemPrefix) {
+			names.Insert(user.Name)
+		}
+		for _, group := range user.Spec.Groups {
+			if !strings.HasPrefix(group, iamv1alpha1.SystemPrefix) {
+				groups.Insert(group)
+			}
+		}
+	}
+
+	var rules []rbacv1.PolicyRule
+	if names.Len() > 0 {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups:     []string{""},
+			Resources:     []string{"users"},
+			Verbs:         []string{"impersonate"},
+			ResourceNames: sets.List(names),
+		})
+	}
+	if groups.Len() > 0 {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups:     []string{""},
+			Resources:     []string{"groups"},
+			Verbs:         []string{"impersonate"},
+			ResourceNames: sets.List(groups),
+		})
+	}
+	return rules
+}
+
+// ensureImpersonator 在成员集群中创建或更新 impersonator 的命名空间、ServiceAccount、ClusterRole、
+// ClusterRoleBinding 以及 token Secret
+func ensureImpersonator(ctx context.Context, client kubernetes.Interface, rules []rbacv1.PolicyRule) error {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ImpersonatorNamespace}}
+	if _, err := client.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %w", ImpersonatorNamespace, err)
+	}
+
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: ImpersonatorNamespace, Name: ImpersonatorName}}
+	if _, err := client.CoreV1().ServiceAccounts(ImpersonatorNamespace).Create(ctx, serviceAccount, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create service account %s/%s: %w", ImpersonatorNamespace, ImpersonatorName, err)
+	}
+
+	if err := ensureClusterRole(ctx, client, rules); err != nil {
+		return err
+	}
+	if err := ensureClusterRoleBinding(ctx, client); err != nil {
+		return err
+	}
+
+	// 1.24 之后 ServiceAccount 不再自动生成 token Secret，这里显式创建，由成员集群的 token 控制器填充
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   ImpersonatorNamespace,
+			Name:        impersonatorTokenSecretName,
+			Annotations: map[string]string{corev1.ServiceAccountNameKey: ImpersonatorName},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+	if _, err := client.CoreV1().Secrets(ImpersonatorNamespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create secret %s/%s: %w", ImpersonatorNamespace, impersonatorTokenSecretName, err)
+	}
+	return nil
+}
+
+func ensureClusterRole(ctx context.Context, client kubernetes.Interface, rules []rbacv1.PolicyRule) error {
+	existing, err := client.RbacV1().ClusterRoles().Get(ctx, ImpersonatorName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: ImpersonatorName}, Rules: rules}
+		if _, err := client.RbacV1().ClusterRoles().Create(ctx, clusterRole, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create cluster role %s: %w", ImpersonatorName, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get cluster role %s: %w", ImpersonatorName, err)
+	}
+	if apiequality.Semantic.DeepEqual(existing.Rules, rules) {
+		return nil
+	}
+
+	clusterRole := existing.DeepCopy()
+	clusterRole.Rules = rules
+	if _, err := client.RbacV1().ClusterRoles().Update(ctx, clusterRole, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update cluster role %s: %w", ImpersonatorName, err)
+	}
+	return nil
+}
+
+func ensureClusterRoleBinding(ctx context.Context, client kubernetes.Interface) error {
+	subjects := []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Namespace: ImpersonatorNamespace, Name: ImpersonatorName}}
+
+	existing, err := client.RbacV1().ClusterRoleBindings().Get(ctx, ImpersonatorName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		binding := &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: ImpersonatorName},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: ImpersonatorName},
+			Subjects:   subjects,
+		}
+		if _, err := client.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create cluster role binding %s: %w", ImpersonatorName, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get cluster role binding %s: %w", ImpersonatorName, err)
+	}
+	// roleRef 不可修改，这里只纠正 subjects
+	if apiequality.Semantic.DeepEqual(existing.Subjects, subjects) {
+		return nil
+	}
+
+	binding := existing.DeepCopy()
+	binding.Subjects = subjects
+	if _, err := client.RbacV1().ClusterRoleBindings().Update(ctx, binding, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update cluster role binding %s: %w", ImpersonatorName, err)
+	}
+	return nil
+}
+
+// getImpersonatorToken 读取成员集群中 impersonator 的 token，token 控制器尚未填充时返回错误
+func getImpersonatorToken(ctx context.Context, client kubernetes.Interface) ([]byte, error) {
+	secret, err := client.CoreV1().Secrets(ImpersonatorNamespace).Get(ctx, impersonatorTokenSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", ImpersonatorNamespace, impersonatorTokenSecretName, err)
+	}
+	token := secret.Data[corev1.ServiceAccountTokenKey]
+	if len(token) == 0 {
+		return nil, fmt.Errorf("the token of secret %s/%s is not populated yet", ImpersonatorNamespace, impersonatorTokenSecretName)
+	}
+	return token, nil
+}
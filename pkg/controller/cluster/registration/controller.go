@@ -0,0 +1,194 @@
+package registration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	certificatesinformers "k8s.io/client-go/informers/certificates/v1"
+	"k8s.io/client-go/kubernetes"
+	certificateslisters "k8s.io/client-go/listers/certificates/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	clusterinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/cluster.kubellm.io/v1alpha1"
+	clusterlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/cluster.kubellm.io/v1alpha1"
+)
+
+// ControllerName 是集群注册控制器的名称
+const ControllerName = "cluster-registration-controller"
+
+// Controller 处理 kubellmctl register 提交的 CSR。
+// CSR 由 kubellm 的 bootstrap token 提交、申请的是成员集群身份，并且对应的 Pull 模式 Cluster 由同一个 bootstrap token 创建时，
+// 控制器为该身份绑定只能访问自己 Cluster 的 ClusterRole，然后批准 CSR；不满足条件的 kubellm CSR 会被拒绝，其他 CSR 不做处理。
+type Controller struct {
+	kubeClient    kubernetes.Interface
+	csrLister     certificateslisters.CertificateSigningRequestLister
+	csrSynced     cache.InformerSynced
+	clusterLister clusterlisters.ClusterLister
+	clusterSynced cache.InformerSynced
+
+	// queue 中的元素为 CSR 名称
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewController 创建集群注册控制器，kubeClient 用于批准 CSR 以及创建 RBAC 对象
+func NewController(
+	kubeClient kubernetes.Interface,
+	csrInformer certificatesinformers.CertificateSigningRequestInformer,
+	clusterInformer clusterinformers.ClusterInformer,
+) (*Controller, error) {
+	c := &Controller{
+		kubeClient:    kubeClient,
+		csrLister:     csrInformer.Lister(),
+		csrSynced:     csrInformer.Informer().HasSynced,
+		clusterLister: clusterInformer.Lister(),
+		clusterSynced: clusterInformer.Informer().HasSynced,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: ControllerName},
+		),
+	}
+
+	_, err := csrInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			csr, ok := obj.(*certificatesv1.CertificateSigningRequest)
+			return ok && isBootstrapCSR(csr) && !isCSRFinished(csr)
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueue,
+			UpdateFunc: func(_, newObj interface{}) { c.enqueue(newObj) },
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run 启动 workers 个协程处理队列，直到 ctx 结束
+func (c *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting controller", "controller", ControllerName)
+	defer logger.Info("Shutting down controller", "controller", ControllerName)
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), c.csrSynced, c.clusterSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (c *Controller) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncCSR(ctx, key); err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "Error syncing certificate signing request", "csr", key)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+// syncCSR 校验 CSR 并批准或拒绝
+func (c *Controller) syncCSR(ctx context.Context, name string) error {
+	csr, err := c.csrLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !isBootstrapCSR(csr) || isCSRFinished(csr) {
+		return nil
+	}
+
+	clusterName, err := clusterNameFromCSR(csr)
+	if err != nil {
+		return c.deny(ctx, csr, err.Error())
+	}
+	cluster, err := c.clusterLister.Get(clusterName)
+	if apierrors.IsNotFound(err) {
+		// register 先创建 Cluster 再提交 CSR，这里通常只是缓存尚未同步，稍后重试
+		return fmt.Errorf("cluster %s is not found", clusterName)
+	}
+	if err != nil {
+		return err
+	}
+	if cluster.Spec.SyncMode != clusterv1alpha1.Pull {
+		return c.deny(ctx, csr, fmt.Sprintf("cluster %s is not in %s mode", clusterName, clusterv1alpha1.Pull))
+	}
+	if cluster.Annotations[BootstrapUserAnnotation] != csr.Spec.Username {
+		return c.deny(ctx, csr, fmt.Sprintf("cluster %s is not registered by %s", clusterName, csr.Spec.Username))
+	}
+
+	if err := ensureClusterRBAC(ctx, c.kubeClient, cluster); err != nil {
+		return err
+	}
+	return c.approve(ctx, csr, clusterName)
+}
+
+func (c *Controller) approve(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, clusterName string) error {
+	csr = csr.DeepCopy()
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:           certificatesv1.CertificateApproved,
+		Status:         corev1.ConditionTrue,
+		Reason:         "KubellmClusterRegistration",
+		Message:        fmt.Sprintf("Auto approved by %s for cluster %s", ControllerName, clusterName),
+		LastUpdateTime: metav1.Now(),
+	})
+	if _, err := c.kubeClient.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, csr.Name, csr, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	klog.FromContext(ctx).Info("Approved certificate signing request", "csr", csr.Name, "cluster", clusterName, "requestor", csr.Spec.Username)
+	return nil
+}
+
+func (c *Controller) deny(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, message string) error {
+	csr = csr.DeepCopy()
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:           certificatesv1.CertificateDenied,
+		Status:         corev1.ConditionTrue,
+		Reason:         "KubellmClusterRegistration",
+		Message:        message,
+		LastUpdateTime: metav1.Now(),
+	})
+	if _, err := c.kubeClient.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, csr.Name, csr, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	klog.FromContext(ctx).Info("Denied certificate signing request", "csr", csr.Name, "requestor", csr.Spec.Username, "reason", message)
+	return nil
+}
@@ -0,0 +1,86 @@
+package registration
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strings"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+)
+
+const (
+	// BootstrapGroup 是 kubellmctl token create 创建的 bootstrap token 所属的额外组，
+	// 持有者只能创建 CSR 与 Cluster，不能修改已存在的 Cluster
+	BootstrapGroup = "system:bootstrappers:kubellm:clusters"
+	// ClusterGroup 是成员集群证书的 Organization
+	ClusterGroup = "system:kubellm:clusters"
+	// ClusterUserPrefix 是成员集群证书 CommonName 的前缀，后接 Cluster 名称
+	ClusterUserPrefix = "system:kubellm:cluster:"
+
+	// BootstrapUserAnnotation 记录创建 Cluster 的 bootstrap token 用户，
+	// 只有同一个 bootstrap token 提交的 CSR 才会被批准为该集群的身份
+	BootstrapUserAnnotation = "cluster.kubellm.io/bootstrap-user"
+)
+
+// ClusterUserName 返回成员集群证书中的用户名，也是该集群 ClusterRole 与 ClusterRoleBinding 的名称
+func ClusterUserName(clusterName string) string {
+	return ClusterUserPrefix + clusterName
+}
+
+// allowedUsages 是成员集群客户端证书允许的用途
+var allowedUsages = []certificatesv1.KeyUsage{
+	certificatesv1.UsageDigitalSignature,
+	certificatesv1.UsageKeyEncipherment,
+	certificatesv1.UsageClientAuth,
+}
+
+// isBootstrapCSR 判断 CSR 是否由 kubellm 的 bootstrap token 提交
+func isBootstrapCSR(csr *certificatesv1.CertificateSigningRequest) bool {
+	return csr.Spec.SignerName == certificatesv1.KubeAPIServerClientSignerName &&
+		slices.Contains(csr.Spec.Groups, BootstrapGroup)
+}
+
+// clusterNameFromCSR 校验 bootstrap token 提交的 CSR 并返回其申请的 Cluster 名称。
+// 证书只能包含成员集群身份，不能带有 SAN，用途只能是客户端认证。
+func clusterNameFromCSR(csr *certificatesv1.CertificateSigningRequest) (string, error) {
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return "", fmt.Errorf("the request is not a PEM encoded certificate request")
+	}
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse certificate request: %w", err)
+	}
+
+	clusterName, ok := strings.CutPrefix(request.Subject.CommonName, ClusterUserPrefix)
+	if !ok || len(clusterName) == 0 {
+		return "", fmt.Errorf("the common name must be %s<cluster>", ClusterUserPrefix)
+	}
+	if !slices.Equal(request.Subject.Organization, []string{ClusterGroup}) {
+		return "", fmt.Errorf("the organization must be %s", ClusterGroup)
+	}
+	if len(request.DNSNames) > 0 || len(request.IPAddresses) > 0 || len(request.EmailAddresses) > 0 || len(request.URIs) > 0 {
+		return "", fmt.Errorf("subject alternative names are not allowed")
+	}
+	if !slices.Contains(csr.Spec.Usages, certificatesv1.UsageClientAuth) {
+		return "", fmt.Errorf("the usages must contain %s", certificatesv1.UsageClientAuth)
+	}
+	for _, usage := range csr.Spec.Usages {
+		if !slices.Contains(allowedUsages, usage) {
+			return "", fmt.Errorf("usage %s is not allowed", usage)
+		}
+	}
+	return clusterName, nil
+}
+
+// isCSRFinished 判断 CSR 是否已经被批准或拒绝
+func isCSRFinished(csr *certificatesv1.CertificateSigningRequest) bool {
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == certificatesv1.CertificateApproved || condition.Type == certificatesv1.CertificateDenied {
+			return true
+		}
+	}
+	return false
+}
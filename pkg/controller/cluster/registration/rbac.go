@@ -0,0 +1,102 @@
+package registration
+
+import (
+	"context"
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+)
+
+// clusterRules 返回成员集群身份在控制面中的权限：只能读取自己的 Cluster、更新它的状态，并建立自己的隧道。
+// 成员集群不能修改 Spec，否则可以把自己改为 Push 模式并指向任意地址与凭证；spec.id 通过 status 子资源上报。
+// 此外可以读取 User，kubellm-agent 据此限定 impersonator 能够模拟的用户与组，User 的密码哈希不会返回。
+func clusterRules(clusterName string) []rbacv1.PolicyRule {
+	names := []string{clusterName}
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups:     []string{clusterv1alpha1.SchemeGroupVersion.Group},
+			Resources:     []string{clusterv1alpha1.ResourcePluralCluster},
+			Verbs:         []string{"get", "list", "watch"},
+			ResourceNames: names,
+		},
+		{
+			APIGroups:     []string{clusterv1alpha1.SchemeGroupVersion.Group},
+			Resources:     []string{clusterv1alpha1.ResourcePluralCluster + "/status"},
+			Verbs:         []string{"get", "update", "patch"},
+			ResourceNames: names,
+		},
+		{
+			APIGroups:     []string{clusterv1alpha1.SchemeGroupVersion.Group},
+			Resources:     []string{clusterv1alpha1.ResourcePluralCluster + "/tunnel"},
+			Verbs:         []string{"get"},
+			ResourceNames: names,
+		},
+		{
+			APIGroups: []string{iamv1alpha1.SchemeGroupVersion.Group},
+			Resources: []string{"users"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+	}
+}
+
+// ensureClusterRBAC 在控制面中为成员集群身份创建或更新 ClusterRole 与 ClusterRoleBinding，
+// 它们属于 Cluster，随 Cluster 一起被垃圾回收
+func ensureClusterRBAC(ctx context.Context, client kubernetes.Interface, cluster *clusterv1alpha1.Cluster) error {
+	name := ClusterUserName(cluster.Name)
+	ownerReferences := []metav1.OwnerReference{*metav1.NewControllerRef(cluster, clusterv1alpha1.SchemeGroupVersion.WithKind(clusterv1alpha1.ResourceKindCluster))}
+	rules := clusterRules(cluster.Name)
+
+	existingRole, err := client.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		clusterRole := &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: name, OwnerReferences: ownerReferences},
+			Rules:      rules,
+		}
+		if _, err := client.RbacV1().ClusterRoles().Create(ctx, clusterRole, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create cluster role %s: %w", name, err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get cluster role %s: %w", name, err)
+	case !apiequality.Semantic.DeepEqual(existingRole.Rules, rules) || !apiequality.Semantic.DeepEqual(existingRole.OwnerReferences, ownerReferences):
+		// 同名 Cluster 被删除后重新注册时，纠正规则并指向新的 Cluster
+		clusterRole := existingRole.DeepCopy()
+		clusterRole.Rules = rules
+		clusterRole.OwnerReferences = ownerReferences
+		if _, err := client.RbacV1().ClusterRoles().Update(ctx, clusterRole, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update cluster role %s: %w", name, err)
+		}
+	}
+
+	subjects := []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: name}}
+	existingBinding, err := client.RbacV1().ClusterRoleBindings().Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		binding := &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, OwnerReferences: ownerReferences},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: name},
+			Subjects:   subjects,
+		}
+		if _, err := client.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create cluster role binding %s: %w", name, err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get cluster role binding %s: %w", name, err)
+	case !apiequality.Semantic.DeepEqual(existingBinding.Subjects, subjects) || !apiequality.Semantic.DeepEqual(existingBinding.OwnerReferences, ownerReferences):
+		// roleRef 不可修改，这里只纠正 subjects 与 ownerReferences
+		binding := existingBinding.DeepCopy()
+		binding.Subjects = subjects
+		binding.OwnerReferences = ownerReferences
+		if _, err := client.RbacV1().ClusterRoleBindings().Update(ctx, binding, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update cluster role binding %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package remedy
+
+import (
+	"context"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	clusterinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/cluster.kubellm.io/v1alpha1"
+	clusterlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/cluster.kubellm.io/v1alpha1"
+)
+
+// ControllerName 是 Remedy 控制器的名称
+const ControllerName = "remedy-controller"
+
+// Controller 根据所有 Remedy 策略评估每个集群的条件，并将命中的动作写入集群的 status.remedyActions
+type Controller struct {
+	client        versioned.Interface
+	clusterLister clusterlisters.ClusterLister
+	clusterSynced cache.InformerSynced
+	remedyLister  clusterlisters.RemedyLister
+	remedySynced  cache.InformerSynced
+
+	// queue 中的元素为集群名称
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewController 创建 Remedy 控制器
+func NewController(client versioned.Interface, clusterInformer clusterinformers.ClusterInformer, remedyInformer clusterinformers.RemedyInformer) (*Controller, error) {
+	c := &Controller{
+		client:        client,
+		clusterLister: clusterInformer.Lister(),
+		clusterSynced: clusterInformer.Informer().HasSynced,
+		remedyLister:  remedyInformer.Lister(),
+		remedySynced:  remedyInformer.Informer().HasSynced,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: ControllerName},
+		),
+	}
+
+	_, err := clusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueCluster,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldCluster := oldObj.(*clusterv1alpha1.Cluster)
+			newCluster := newObj.(*clusterv1alpha1.Cluster)
+			// 只关心条件变化，以及 remedyActions 被其他人修改的情况
+			if !apiequality.Semantic.DeepEqual(oldCluster.Status.Conditions, newCluster.Status.Conditions) ||
+				!apiequality.Semantic.DeepEqual(oldCluster.Status.RemedyActions, newCluster.Status.RemedyActions) {
+				c.enqueueCluster(newObj)
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = remedyInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueRemedyClusters,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldRemedy := oldObj.(*clusterv1alpha1.Remedy)
+			newRemedy := newObj.(*clusterv1alpha1.Remedy)
+			if apiequality.Semantic.DeepEqual(oldRemedy.Spec, newRemedy.Spec) {
+				return
+			}
+			// 旧策略作用范围内的集群也需要重新计算，以便移除不再适用的动作
+			c.enqueueRemedyClusters(oldObj)
+			c.enqueueRemedyClusters(newObj)
+		},
+		DeleteFunc: c.enqueueRemedyClusters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueueCluster(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// enqueueRemedyClusters 将 Remedy 作用范围内的所有集群加入队列
+func (c *Controller) enqueueRemedyClusters(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	remedy, ok := obj.(*clusterv1alpha1.Remedy)
+	if !ok {
+		return
+	}
+
+	if remedy.Spec.ClusterAffinity != nil {
+		for _, name := range remedy.Spec.ClusterAffinity.ClusterNames {
+			c.queue.Add(name)
+		}
+		return
+	}
+	clusters, err := c.clusterLister.List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, cluster := range clusters {
+		c.queue.Add(cluster.Name)
+	}
+}
+
+// Run 启动 workers 个协程处理队列，直到 ctx 结束
+func (c *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting controller", "controller", ControllerName)
+	defer logger.Info("Shutting down controller", "controller", ControllerName)
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), c.clusterSynced, c.remedySynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (c *Controller) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	requeueAfter, err := c.syncCluster(ctx, key)
+	if err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "Error syncing cluster remedy actions", "cluster", key)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	if requeueAfter > 0 {
+		// 有条件尚未满足持续时间要求，到期后再次计算
+		c.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+// syncCluster 计算集群的 remedyActions 并在变化时写回，返回下一次需要检查的间隔，0 表示无需再次检查
+func (c *Controller) syncCluster(ctx context.Context, name string) (time.Duration, error) {
+	cluster, err := c.clusterLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if !cluster.DeletionTimestamp.IsZero() {
+		return 0, nil
+	}
+
+	remedies, err := c.remedyLister.List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+	actions, requeueAfter := calculateActions(remedies, cluster, time.Now())
+	if apiequality.Semantic.DeepEqual(actions, cluster.Status.RemedyActions) {
+		return requeueAfter, nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster = cluster.DeepCopy()
+		cluster.Status.RemedyActions = actions
+		_, updateErr := c.client.ClusterV1alpha1().Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		if updateErr == nil || !apierrors.IsConflict(updateErr) {
+			return updateErr
+		}
+		latest, err := c.client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		cluster = latest
+		return updateErr
+	})
+	if err != nil {
+		return 0, err
+	}
+	klog.FromContext(ctx).Info("Updated cluster remedy actions", "cluster", name, "actions", actions)
+	return requeueAfter, nil
+}
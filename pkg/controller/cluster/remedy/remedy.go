@@ -0,0 +1,101 @@
+package remedy
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// calculateActions 根据所有 Remedy 计算集群需要执行的动作（去重并排序），
+// 同时返回尚未满足持续时间要求的条件中最近一个到期的间隔，0 表示无需定时再次检查
+func calculateActions(remedies []*clusterv1alpha1.Remedy, cluster *clusterv1alpha1.Cluster, now time.Time) ([]string, time.Duration) {
+	actions := sets.New[string]()
+	var requeueAfter time.Duration
+	for _, remedy := range remedies {
+		if !isClusterSelected(remedy, cluster.Name) || len(remedy.Spec.Actions) == 0 {
+			continue
+		}
+		matched, wait := matchDecisions(remedy.Spec.DecisionMatches, cluster, now)
+		if !matched {
+			if wait > 0 && (requeueAfter == 0 || wait < requeueAfter) {
+				requeueAfter = wait
+			}
+			continue
+		}
+		for _, action := range remedy.Spec.Actions {
+			actions.Insert(string(action))
+		}
+	}
+	if actions.Len() == 0 {
+		return nil, requeueAfter
+	}
+	return sets.List(actions), requeueAfter
+}
+
+// isClusterSelected 判断集群是否在 Remedy 的作用范围内，未设置 ClusterAffinity 时作用于所有集群
+func isClusterSelected(remedy *clusterv1alpha1.Remedy, clusterName string) bool {
+	if remedy.Spec.ClusterAffinity == nil {
+		return true
+	}
+	for _, name := range remedy.Spec.ClusterAffinity.ClusterNames {
+		if name == clusterName {
+			return true
+		}
+	}
+	return false
+}
+
+// matchDecisions 判断集群是否命中任意一个 DecisionMatch，DecisionMatches 为空时视为无条件命中。
+// 未命中时返回最近一个可能在持续时间到期后命中的间隔。
+func matchDecisions(matches []clusterv1alpha1.DecisionMatch, cluster *clusterv1alpha1.Cluster, now time.Time) (bool, time.Duration) {
+	if len(matches) == 0 {
+		return true, 0
+	}
+
+	var requeueAfter time.Duration
+	for _, match := range matches {
+		if match.ClusterConditionMatch == nil {
+			continue
+		}
+		matched, wait := matchClusterCondition(match.ClusterConditionMatch, cluster, now)
+		if matched {
+			return true, 0
+		}
+		if wait > 0 && (requeueAfter == 0 || wait < requeueAfter) {
+			requeueAfter = wait
+		}
+	}
+	return false, requeueAfter
+}
+
+// matchClusterCondition 判断集群条件是否满足要求。设置了 Duration 时，条件需从 LastTransitionTime 起
+// 持续满足至少 Duration 才算命中；当前满足但持续时间不足时返回剩余的等待时间。
+// 集群没有对应类型的条件时视为不命中。
+func matchClusterCondition(requirement *clusterv1alpha1.ClusterConditionRequirement, cluster *clusterv1alpha1.Cluster, now time.Time) (bool, time.Duration) {
+	condition := meta.FindStatusCondition(cluster.Status.Conditions, requirement.ConditionType)
+	if condition == nil {
+		return false, 0
+	}
+
+	var satisfied bool
+	switch requirement.Operator {
+	case clusterv1alpha1.ClusterConditionEqual:
+		satisfied = condition.Status == requirement.ConditionStatus
+	case clusterv1alpha1.ClusterConditionNotEqual:
+		satisfied = condition.Status != requirement.ConditionStatus
+	}
+	if !satisfied {
+		return false, 0
+	}
+
+	if requirement.Duration == nil {
+		return true, 0
+	}
+	if wait := condition.LastTransitionTime.Add(requirement.Duration.Duration).Sub(now); wait > 0 {
+		return false, wait
+	}
+	return true, 0
+}
@@ -0,0 +1,176 @@
+package status
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	resourcehelper "k8s.io/component-helpers/resource"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/util/modeling"
+)
+
+// clusterHealth 描述一次健康检查的结果
+type clusterHealth struct {
+	// online 表示能否与成员集群的 API Server 建立连接
+	online bool
+	// healthy 表示成员集群的 API Server 是否就绪
+	healthy bool
+}
+
+// checkClusterHealth 依次探测 /readyz 与 /healthz，兼容不提供 /readyz 的老版本集群
+func checkClusterHealth(ctx context.Context, client kubernetes.Interface) clusterHealth {
+	healthStatus, err := healthEndpointCheck(ctx, client, "/readyz")
+	if err != nil && healthStatus == http.StatusNotFound {
+		healthStatus, err = healthEndpointCheck(ctx, client, "/healthz")
+	}
+	if err != nil && healthStatus == 0 {
+		return clusterHealth{}
+	}
+	return clusterHealth{online: true, healthy: healthStatus == http.StatusOK}
+}
+
+func healthEndpointCheck(ctx context.Context, client kubernetes.Interface, path string) (int, error) {
+	var healthStatus int
+	err := client.Discovery().RESTClient().Get().AbsPath(path).Do(ctx).StatusCode(&healthStatus).Error()
+	return healthStatus, err
+}
+
+// getAPIEnablements 通过 discovery 获取成员集群安装的 API，忽略子资源并按 GroupVersion 排序
+func getAPIEnablements(client discovery.DiscoveryInterface) ([]clusterv1alpha1.APIEnablement, error) {
+	_, resourceLists, err := client.ServerGroupsAndResources()
+	// 部分聚合 API 不可用时 discovery 会返回 ErrGroupDiscoveryFailed，此时仍然使用已获取到的部分结果
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	apiEnablements := make([]clusterv1alpha1.APIEnablement, 0, len(resourceLists))
+	for _, list := range resourceLists {
+		var apiResources []clusterv1alpha1.APIResource
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			apiResources = append(apiResources, clusterv1alpha1.APIResource{Name: r.Name, Kind: r.Kind})
+		}
+		sort.SliceStable(apiResources, func(i, j int) bool {
+			return apiResources[i].Name < apiResources[j].Name
+		})
+		apiEnablements = append(apiEnablements, clusterv1alpha1.APIEnablement{GroupVersion: list.GroupVersion, Resources: apiResources})
+	}
+	sort.SliceStable(apiEnablements, func(i, j int) bool {
+		return apiEnablements[i].GroupVersion < apiEnablements[j].GroupVersion
+	})
+	return apiEnablements, nil
+}
+
+// getNodeSummary 统计节点总数与就绪节点数
+func getNodeSummary(nodes []*corev1.Node) *clusterv1alpha1.NodeSummary {
+	summary := &clusterv1alpha1.NodeSummary{TotalNum: int32(len(nodes))}
+	for _, node := range nodes {
+		if isNodeReady(node) {
+			summary.ReadyNum++
+		}
+	}
+	return summary
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// getNodeRequested 统计每个节点上已调度 Pod 的资源请求之和，用于计算节点剩余资源
+func getNodeRequested(pods []*corev1.Pod) map[string]corev1.ResourceList {
+	nodeRequested := make(map[string]corev1.ResourceList)
+	for _, pod := range pods {
+		if len(pod.Spec.NodeName) == 0 {
+			continue
+		}
+		if _, ok := nodeRequested[pod.Spec.NodeName]; !ok {
+			nodeRequested[pod.Spec.NodeName] = make(corev1.ResourceList)
+		}
+		addResourceList(nodeRequested[pod.Spec.NodeName], resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{}))
+	}
+	return nodeRequested
+}
+
+// getResourceSummary 汇总集群的可分配、已分配与待分配资源。
+// 已分配为已调度 Pod 的资源请求之和，待分配为尚未调度的 Pending Pod 的资源请求之和，
+// 两者都额外统计 Pod 数量，以便与节点可分配的 pods 数量比较。
+// 同时按 resourceModels 统计每个等级中的节点数量。
+func getResourceSummary(nodes []*corev1.Node, pods []*corev1.Pod, nodeRequested map[string]corev1.ResourceList,
+	resourceModels []clusterv1alpha1.ResourceModel) *clusterv1alpha1.ResourceSummary {
+	allocatable := make(corev1.ResourceList)
+	for _, node := range nodes {
+		addResourceList(allocatable, node.Status.Allocatable)
+	}
+
+	allocated := make(corev1.ResourceList)
+	for _, requested := range nodeRequested {
+		addResourceList(allocated, requested)
+	}
+	allocating := make(corev1.ResourceList)
+	var allocatedPods, allocatingPods int64
+	for _, pod := range pods {
+		if len(pod.Spec.NodeName) > 0 {
+			allocatedPods++
+		} else if pod.Status.Phase == corev1.PodPending {
+			addResourceList(allocating, resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{}))
+			allocatingPods++
+		}
+	}
+	allocated[corev1.ResourcePods] = *resource.NewQuantity(allocatedPods, resource.DecimalSI)
+	allocating[corev1.ResourcePods] = *resource.NewQuantity(allocatingPods, resource.DecimalSI)
+
+	available := make([]corev1.ResourceList, 0, len(nodes))
+	for _, node := range nodes {
+		available = append(available, subtractResourceList(node.Status.Allocatable, nodeRequested[node.Name]))
+	}
+
+	return &clusterv1alpha1.ResourceSummary{
+		Allocatable:          allocatable,
+		Allocated:            allocated,
+		Allocating:           allocating,
+		AllocatableModelings: modeling.New(resourceModels).AllocatableModelings(available),
+	}
+}
+
+// addResourceList 将 add 中的资源累加到 list
+func addResourceList(list, add corev1.ResourceList) {
+	for name, quantity := range add {
+		if value, ok := list[name]; ok {
+			value.Add(quantity)
+			list[name] = value
+		} else {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// subtractResourceList 返回 list 减去 sub 后的结果，结果中的资源不会小于 0
+func subtractResourceList(list, sub corev1.ResourceList) corev1.ResourceList {
+	result := list.DeepCopy()
+	for name, quantity := range sub {
+		value, ok := result[name]
+		if !ok {
+			continue
+		}
+		value.Sub(quantity)
+		if value.Sign() < 0 {
+			value.Set(0)
+		}
+		result[name] = value
+	}
+	return result
+}
@@ -0,0 +1,383 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	clusterinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/cluster.kubellm.io/v1alpha1"
+	clusterlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/util/gpu"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+)
+
+// ControllerName 是集群状态控制器的名称
+const ControllerName = "cluster-status-controller"
+
+const (
+	// clusterReady 表示成员集群的 API Server 健康
+	clusterReady = "ClusterReady"
+	// clusterNotReady 表示成员集群的 API Server 可以连接但不健康
+	clusterNotReady = "ClusterNotReady"
+	// clusterNotReachable 表示无法连接成员集群的 API Server
+	clusterNotReachable = "ClusterNotReachable"
+
+	clusterReadyMessage        = "cluster is healthy and ready to accept workloads"
+	clusterNotReadyMessage     = "cluster is reachable but health endpoint responded without ok"
+	clusterNotReachableMessage = "cluster is not reachable"
+
+	// clusterIDDuplicated 表示存在其他 spec.id 相同的 Cluster，即它们指向同一个物理集群
+	clusterIDDuplicated = "ClusterIDDuplicated"
+)
+
+// managedConditions 是本控制器负责维护的条件
+var managedConditions = []string{clusterv1alpha1.ClusterConditionReady, clusterv1alpha1.ClusterConditionDuplicateID}
+
+// ClientBuilderFunc 根据 Cluster 构造访问成员集群的客户端。
+// 控制面使用 SecretRef 中的凭证，kubellm-agent 使用成员集群内的凭证，
+// 测试时可以替换为 fake 客户端或指向 httptest 服务的客户端。
+type ClientBuilderFunc func(cluster *clusterv1alpha1.Cluster) (kubernetes.Interface, error)
+
+// NewClientBuilder 返回从成员集群客户端缓存中获取客户端的 ClientBuilderFunc
+func NewClientBuilder(clientCache *membercluster.ClientCache) ClientBuilderFunc {
+	return func(cluster *clusterv1alpha1.Cluster) (kubernetes.Interface, error) {
+		clients, err := clientCache.Get(cluster)
+		if err != nil {
+			return nil, err
+		}
+		return clients.Kube, nil
+	}
+}
+
+// Controller 周期性地从成员集群收集版本、API、节点与资源信息，并写回 Cluster 的 status。
+// spec.id 为空时还会收集集群 ID，并通过索引发现指向同一个物理集群的多个 Cluster。
+type Controller struct {
+	client         versioned.Interface
+	clusterLister  clusterlisters.ClusterLister
+	clusterIndexer cache.Indexer
+	clusterSynced  cache.InformerSynced
+
+	// clientBuilder 用于构造成员集群的客户端
+	clientBuilder ClientBuilderFunc
+	// statusUpdateFrequency 是同一个集群两次状态收集之间的间隔
+	statusUpdateFrequency time.Duration
+	// clusterFilter 决定哪些集群由本控制器处理，为空时处理所有集群
+	clusterFilter func(cluster *clusterv1alpha1.Cluster) bool
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewController 创建集群状态控制器。
+// 控制面只处理 Push 模式的集群，Pull 模式的集群由其中的 kubellm-agent 通过 clusterFilter 只处理自身。
+func NewController(
+	client versioned.Interface,
+	clusterInformer clusterinformers.ClusterInformer,
+	clientBuilder ClientBuilderFunc,
+	statusUpdateFrequency time.Duration,
+	clusterFilter func(cluster *clusterv1alpha1.Cluster) bool,
+) (*Controller, error) {
+	// 按 spec.id 等字段建立索引，用于发现指向同一个物理集群的多个 Cluster
+	err := membercluster.AddClusterIndexers(clusterInformer.Informer())
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Controller{
+		client:                client,
+		clusterLister:         clusterInformer.Lister(),
+		clusterIndexer:        clusterInformer.Informer().GetIndexer(),
+		clusterSynced:         clusterInformer.Informer().HasSynced,
+		clientBuilder:         clientBuilder,
+		statusUpdateFrequency: statusUpdateFrequency,
+		clusterFilter:         clusterFilter,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: ControllerName},
+		),
+	}
+	_, err = clusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldCluster := oldObj.(*clusterv1alpha1.Cluster)
+			newCluster := newObj.(*clusterv1alpha1.Cluster)
+			// 只关心 spec 的变化，status 的变化由本控制器自身产生，周期性收集由 AddAfter 驱动
+			if oldCluster.Generation != newCluster.Generation {
+				c.enqueue(newObj)
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	if c.clusterFilter != nil && !c.clusterFilter(obj.(*clusterv1alpha1.Cluster)) {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run 启动 workers 个协程处理队列，直到 ctx 结束
+func (c *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting controller", "controller", ControllerName)
+	defer logger.Info("Shutting down controller", "controller", ControllerName)
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), c.clusterSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (c *Controller) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	requeue, err := c.syncCluster(ctx, key)
+	if err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "Error syncing cluster status", "cluster", key)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	if requeue {
+		// 无论状态是否变化，都按固定频率重新收集
+		c.queue.AddAfter(key, c.statusUpdateFrequency)
+	}
+	return true
+}
+
+// syncCluster 收集并更新集群状态，返回值表示是否需要按周期重新收集
+func (c *Controller) syncCluster(ctx context.Context, name string) (bool, error) {
+	cluster, err := c.clusterLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		// 集群已被删除，不再重新入队
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !cluster.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	// 同步模式可能已经变化，不再处理的集群不重新入队
+	if c.clusterFilter != nil && !c.clusterFilter(cluster) {
+		return false, nil
+	}
+
+	currentStatus := c.collectClusterStatus(ctx, cluster)
+	// 只在集群就绪时收集 ID，避免对不可达的集群重复等待超时
+	if len(cluster.Spec.ID) == 0 && meta.IsStatusConditionTrue(currentStatus.Conditions, clusterv1alpha1.ClusterConditionReady) {
+		if updated, err := c.syncClusterID(ctx, cluster); err != nil {
+			// 收集 ID 失败不影响状态的更新，下一个周期会重试
+			utilruntime.HandleErrorWithContext(ctx, err, "Failed to sync cluster ID", "cluster", cluster.Name)
+		} else {
+			cluster = updated
+		}
+	}
+	if err := c.setDuplicateIDCondition(cluster, currentStatus); err != nil {
+		return false, err
+	}
+	return true, c.updateStatusIfNeeded(ctx, cluster, currentStatus)
+}
+
+// syncClusterID 在 spec.id 为空时从成员集群收集集群 ID，并通过 status 子资源写回 spec。
+// kubellm-agent 运行同一个控制器，只有 status 子资源的修改权限；spec.id 一旦设置便不可修改，由 apiserver 保证。
+func (c *Controller) syncClusterID(ctx context.Context, cluster *clusterv1alpha1.Cluster) (*clusterv1alpha1.Cluster, error) {
+	memberClient, err := c.clientBuilder(cluster)
+	if err != nil {
+		return nil, err
+	}
+	id, err := membercluster.GetClusterID(ctx, memberClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect id of cluster %s: %w", cluster.Name, err)
+	}
+
+	cluster = cluster.DeepCopy()
+	cluster.Spec.ID = id
+	updated, err := c.client.ClusterV1alpha1().Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set id of cluster %s: %w", cluster.Name, err)
+	}
+	klog.FromContext(ctx).Info("Collected cluster ID", "cluster", cluster.Name, "id", id)
+	return updated, nil
+}
+
+// setDuplicateIDCondition 检查是否存在其他 spec.id 相同的 Cluster，存在时设置 DuplicateID 条件，否则移除该条件
+func (c *Controller) setDuplicateIDCondition(cluster *clusterv1alpha1.Cluster, status *clusterv1alpha1.ClusterStatus) error {
+	if len(cluster.Spec.ID) == 0 {
+		meta.RemoveStatusCondition(&status.Conditions, clusterv1alpha1.ClusterConditionDuplicateID)
+		return nil
+	}
+
+	objs, err := c.clusterIndexer.ByIndex(clusterv1alpha1.ClusterFieldID, cluster.Spec.ID)
+	if err != nil {
+		return err
+	}
+	var duplicates []string
+	for _, obj := range objs {
+		if other := obj.(*clusterv1alpha1.Cluster); other.Name != cluster.Name {
+			duplicates = append(duplicates, other.Name)
+		}
+	}
+	if len(duplicates) == 0 {
+		meta.RemoveStatusCondition(&status.Conditions, clusterv1alpha1.ClusterConditionDuplicateID)
+		return nil
+	}
+
+	sort.Strings(duplicates)
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               clusterv1alpha1.ClusterConditionDuplicateID,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: cluster.Generation,
+		Reason:             clusterIDDuplicated,
+		Message:            fmt.Sprintf("cluster ID %s is also used by clusters: %s", cluster.Spec.ID, strings.Join(duplicates, ", ")),
+	})
+	return nil
+}
+
+// collectClusterStatus 收集成员集群的状态。集群不可达或不健康时只更新 Ready 条件，保留上一次收集到的信息。
+func (c *Controller) collectClusterStatus(ctx context.Context, cluster *clusterv1alpha1.Cluster) *clusterv1alpha1.ClusterStatus {
+	logger := klog.FromContext(ctx).WithValues("cluster", cluster.Name)
+	currentStatus := cluster.Status.DeepCopy()
+	// 只有 kubellm-agent 会收集 Pull 模式集群的状态，每次收集都刷新心跳，控制面据此判断 agent 是否失联，见 HeartbeatMonitor
+	if cluster.Spec.SyncMode == clusterv1alpha1.Pull {
+		now := metav1.Now()
+		currentStatus.LastHeartbeatTime = &now
+	}
+
+	memberClient, err := c.clientBuilder(cluster)
+	if err != nil {
+		logger.Error(err, "Failed to build client for member cluster")
+		setReadyCondition(currentStatus, cluster.Generation, metav1.ConditionUnknown, clusterNotReachable, err.Error())
+		return currentStatus
+	}
+
+	health := checkClusterHealth(ctx, memberClient)
+	switch {
+	case !health.online:
+		setReadyCondition(currentStatus, cluster.Generation, metav1.ConditionUnknown, clusterNotReachable, clusterNotReachableMessage)
+		return currentStatus
+	case !health.healthy:
+		setReadyCondition(currentStatus, cluster.Generation, metav1.ConditionFalse, clusterNotReady, clusterNotReadyMessage)
+		return currentStatus
+	}
+	setReadyCondition(currentStatus, cluster.Generation, metav1.ConditionTrue, clusterReady, clusterReadyMessage)
+
+	if serverVersion, err := memberClient.Discovery().ServerVersion(); err != nil {
+		logger.Error(err, "Failed to get kubernetes version of member cluster")
+	} else {
+		currentStatus.KubernetesVersion = serverVersion.GitVersion
+	}
+
+	if apiEnablements, err := getAPIEnablements(memberClient.Discovery()); err != nil {
+		logger.Error(err, "Failed to get api enablements of member cluster")
+	} else {
+		currentStatus.APIEnablements = apiEnablements
+	}
+
+	nodes, err := membercluster.ListNodes(ctx, memberClient)
+	if err != nil {
+		logger.Error(err, "Failed to list nodes of member cluster")
+		return currentStatus
+	}
+	currentStatus.NodeSummary = getNodeSummary(nodes)
+
+	pods, err := membercluster.ListPods(ctx, memberClient)
+	if err != nil {
+		logger.Error(err, "Failed to list pods of member cluster")
+		return currentStatus
+	}
+	nodeRequested := getNodeRequested(pods)
+	currentStatus.ResourceSummary = getResourceSummary(nodes, pods, nodeRequested, cluster.Spec.ResourceModels)
+	currentStatus.GPUInventory = gpu.Inventory(nodes, nodeRequested)
+	return currentStatus
+}
+
+// updateStatusIfNeeded 仅在状态发生变化时调用 UpdateStatus，冲突时基于最新对象重试
+func (c *Controller) updateStatusIfNeeded(ctx context.Context, cluster *clusterv1alpha1.Cluster, currentStatus *clusterv1alpha1.ClusterStatus) error {
+	if apiequality.Semantic.DeepEqual(&cluster.Status, currentStatus) {
+		return nil
+	}
+
+	klog.FromContext(ctx).V(4).Info("Updating cluster status", "cluster", cluster.Name)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster = cluster.DeepCopy()
+		// Ready 条件的 LastTransitionTime 已在 currentStatus 中处理，这里整体替换 status
+		cluster.Status = *currentStatus.DeepCopy()
+		_, updateErr := c.client.ClusterV1alpha1().Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		if updateErr == nil || !apierrors.IsConflict(updateErr) {
+			return updateErr
+		}
+
+		latest, err := c.client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get cluster %s: %w", cluster.Name, err)
+		}
+		// 保留其他控制器写入的条件，只覆盖本控制器负责的字段
+		merged := latest.Status.DeepCopy()
+		merged.KubernetesVersion = currentStatus.KubernetesVersion
+		merged.APIEnablements = currentStatus.APIEnablements
+		merged.NodeSummary = currentStatus.NodeSummary
+		merged.ResourceSummary = currentStatus.ResourceSummary
+		merged.GPUInventory = currentStatus.GPUInventory
+		merged.LastHeartbeatTime = currentStatus.LastHeartbeatTime
+		for _, conditionType := range managedConditions {
+			if condition := meta.FindStatusCondition(currentStatus.Conditions, conditionType); condition != nil {
+				meta.SetStatusCondition(&merged.Conditions, *condition)
+			} else {
+				meta.RemoveStatusCondition(&merged.Conditions, conditionType)
+			}
+		}
+		cluster, currentStatus = latest, merged
+		return updateErr
+	})
+}
+
+func setReadyCondition(status *clusterv1alpha1.ClusterStatus, generation int64, conditionStatus metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               clusterv1alpha1.ClusterConditionReady,
+		Status:             conditionStatus,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
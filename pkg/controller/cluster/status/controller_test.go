@@ -0,0 +1,313 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned/fake"
+	"github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions"
+)
+
+// memberServer 模拟成员集群的 API Server，只提供状态收集用到的接口
+type memberServer struct {
+	*httptest.Server
+	// readyzStatus 是 /readyz 返回的状态码
+	readyzStatus atomic.Int32
+}
+
+func newMemberServer(t *testing.T, nodes []corev1.Node, pods []corev1.Pod) *memberServer {
+	t.Helper()
+	s := &memberServer{}
+	s.readyzStatus.Store(http.StatusOK)
+
+	writeJSON := func(w http.ResponseWriter, obj interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(obj); err != nil {
+			t.Errorf("failed to encode response: %v", err)
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(int(s.readyzStatus.Load()))
+	})
+	mux.HandleFunc("/version", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, version.Info{GitVersion: "v1.33.1"})
+	})
+	mux.HandleFunc("/api", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	})
+	mux.HandleFunc("/apis", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}})
+	})
+	mux.HandleFunc("/api/v1", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, metav1.APIResourceList{
+			TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true},
+				{Name: "pods/status", Kind: "Pod", Namespaced: true},
+				{Name: "nodes", Kind: "Node"},
+			},
+		})
+	})
+	mux.HandleFunc("/api/v1/nodes", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, corev1.NodeList{TypeMeta: metav1.TypeMeta{Kind: "NodeList", APIVersion: "v1"}, Items: nodes})
+	})
+	mux.HandleFunc("/api/v1/pods", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, corev1.PodList{TypeMeta: metav1.TypeMeta{Kind: "PodList", APIVersion: "v1"}, Items: pods})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *memberServer) clientBuilder(*clusterv1alpha1.Cluster) (kubernetes.Interface, error) {
+	return kubernetes.NewForConfig(&restclient.Config{Host: s.URL})
+}
+
+func newTestNode(name string, cpu string, ready bool) corev1.Node {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse(cpu),
+				corev1.ResourcePods: resource.MustParse("110"),
+			},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: readyStatus}},
+		},
+	}
+}
+
+func newTestPod(name, nodeName, cpu string, phase corev1.PodPhase) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: name},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Name:      "main",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func cpuModel(grade uint, min, max string) clusterv1alpha1.ResourceModel {
+	return clusterv1alpha1.ResourceModel{
+		Grade: grade,
+		Ranges: []clusterv1alpha1.ResourceModelRange{{
+			Name: corev1.ResourceCPU,
+			Min:  resource.MustParse(min),
+			Max:  resource.MustParse(max),
+		}},
+	}
+}
+
+// TestSyncClusterTransitions 确认 Ready 条件随成员集群的健康状态在 True、False 与 Unknown 之间变化，
+// 集群不健康或不可达时保留上一次收集到的信息
+func TestSyncClusterTransitions(t *testing.T) {
+	member := newMemberServer(t,
+		[]corev1.Node{
+			newTestNode("node-1", "8", true),
+			newTestNode("node-2", "4", true),
+			newTestNode("node-3", "2", false),
+		},
+		[]corev1.Pod{
+			newTestPod("scheduled-1", "node-1", "2", corev1.PodRunning),
+			newTestPod("scheduled-2", "node-2", "3", corev1.PodRunning),
+			newTestPod("pending", "", "1", corev1.PodPending),
+			// 已结束的 Pod 不计入资源
+			newTestPod("succeeded", "node-1", "4", corev1.PodSucceeded),
+		})
+
+	cluster := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member", Generation: 1},
+		Spec: clusterv1alpha1.ClusterSpec{
+			// 设置 ID 以跳过 ID 的收集
+			ID:       "member-id",
+			SyncMode: clusterv1alpha1.Push,
+			ResourceModels: []clusterv1alpha1.ResourceModel{
+				cpuModel(0, "0", "2"),
+				cpuModel(1, "2", "4"),
+				cpuModel(2, "4", "8"),
+			},
+		},
+	}
+	client := fake.NewSimpleClientset(cluster)
+	informerFactory := externalversions.NewSharedInformerFactory(client, 0)
+	clusterInformer := informerFactory.Cluster().V1alpha1().Clusters()
+
+	var online atomic.Bool
+	online.Store(true)
+	clientBuilder := func(cluster *clusterv1alpha1.Cluster) (kubernetes.Interface, error) {
+		if !online.Load() {
+			// 指向不存在的地址，模拟集群不可达
+			return kubernetes.NewForConfig(&restclient.Config{Host: "http://127.0.0.1:1"})
+		}
+		return member.clientBuilder(cluster)
+	}
+	c, err := NewController(client, clusterInformer, clientBuilder, 0, nil)
+	if err != nil {
+		t.Fatalf("failed to create controller: %v", err)
+	}
+	indexer := clusterInformer.Informer().GetIndexer()
+
+	ctx := context.Background()
+	sync := func() *clusterv1alpha1.Cluster {
+		t.Helper()
+		requeue, err := c.syncCluster(ctx, cluster.Name)
+		if err != nil {
+			t.Fatalf("failed to sync cluster: %v", err)
+		}
+		if !requeue {
+			t.Fatalf("expected the cluster to be requeued")
+		}
+		updated, err := client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("failed to get cluster: %v", err)
+		}
+		// 不启动 informer，直接把最新的对象写入缓存
+		if err := indexer.Update(updated); err != nil {
+			t.Fatalf("failed to update cluster cache: %v", err)
+		}
+		return updated
+	}
+	expectReady := func(cluster *clusterv1alpha1.Cluster, status metav1.ConditionStatus, reason string) {
+		t.Helper()
+		condition := meta.FindStatusCondition(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady)
+		if condition == nil {
+			t.Fatalf("expected Ready condition to be set")
+		}
+		if condition.Status != status || condition.Reason != reason {
+			t.Errorf("expected Ready condition %s/%s, got %s/%s", status, reason, condition.Status, condition.Reason)
+		}
+	}
+	if err := indexer.Add(cluster); err != nil {
+		t.Fatalf("failed to add cluster to cache: %v", err)
+	}
+
+	expectedNodeSummary := &clusterv1alpha1.NodeSummary{TotalNum: 3, ReadyNum: 2}
+	expectedResourceSummary := &clusterv1alpha1.ResourceSummary{
+		Allocatable: corev1.ResourceList{
+			corev1.ResourceCPU:  resource.MustParse("14"),
+			corev1.ResourcePods: resource.MustParse("330"),
+		},
+		Allocated: corev1.ResourceList{
+			corev1.ResourceCPU:  resource.MustParse("5"),
+			corev1.ResourcePods: resource.MustParse("2"),
+		},
+		Allocating: corev1.ResourceList{
+			corev1.ResourceCPU:  resource.MustParse("1"),
+			corev1.ResourcePods: resource.MustParse("1"),
+		},
+		// 剩余 CPU：node-1 为 6，node-2 为 1，node-3 为 2
+		AllocatableModelings: []clusterv1alpha1.AllocatableModeling{
+			{Grade: 0, Count: 1},
+			{Grade: 1, Count: 1},
+			{Grade: 2, Count: 1},
+		},
+	}
+
+	// 成员集群健康时收集所有信息
+	updated := sync()
+	expectReady(updated, metav1.ConditionTrue, clusterReady)
+	if updated.Status.KubernetesVersion != "v1.33.1" {
+		t.Errorf("expected kubernetes version v1.33.1, got %q", updated.Status.KubernetesVersion)
+	}
+	expectedAPIEnablements := []clusterv1alpha1.APIEnablement{{
+		GroupVersion: "v1",
+		Resources:    []clusterv1alpha1.APIResource{{Name: "nodes", Kind: "Node"}, {Name: "pods", Kind: "Pod"}},
+	}}
+	if !apiequality.Semantic.DeepEqual(updated.Status.APIEnablements, expectedAPIEnablements) {
+		t.Errorf("expected api enablements %+v, got %+v", expectedAPIEnablements, updated.Status.APIEnablements)
+	}
+	if !apiequality.Semantic.DeepEqual(updated.Status.NodeSummary, expectedNodeSummary) {
+		t.Errorf("expected node summary %+v, got %+v", expectedNodeSummary, updated.Status.NodeSummary)
+	}
+	if !apiequality.Semantic.DeepEqual(updated.Status.ResourceSummary, expectedResourceSummary) {
+		t.Errorf("expected resource summary %+v, got %+v", expectedResourceSummary, updated.Status.ResourceSummary)
+	}
+
+	// 健康检查失败时 Ready 为 False，保留上一次收集的信息
+	member.readyzStatus.Store(http.StatusInternalServerError)
+	updated = sync()
+	expectReady(updated, metav1.ConditionFalse, clusterNotReady)
+	if !apiequality.Semantic.DeepEqual(updated.Status.ResourceSummary, expectedResourceSummary) {
+		t.Errorf("expected resource summary to be kept, got %+v", updated.Status.ResourceSummary)
+	}
+
+	// 无法连接时 Ready 为 Unknown
+	online.Store(false)
+	updated = sync()
+	expectReady(updated, metav1.ConditionUnknown, clusterNotReachable)
+	if !apiequality.Semantic.DeepEqual(updated.Status.NodeSummary, expectedNodeSummary) {
+		t.Errorf("expected node summary to be kept, got %+v", updated.Status.NodeSummary)
+	}
+
+	// 恢复后 Ready 重新变为 True
+	online.Store(true)
+	member.readyzStatus.Store(http.StatusOK)
+	updated = sync()
+	expectReady(updated, metav1.ConditionTrue, clusterReady)
+}
+
+// TestSyncClusterDuplicateID 确认多个 Cluster 的 spec.id 相同时设置 DuplicateID 条件
+func TestSyncClusterDuplicateID(t *testing.T) {
+	member := newMemberServer(t, nil, nil)
+	first := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "first"},
+		Spec:       clusterv1alpha1.ClusterSpec{ID: "same-id", SyncMode: clusterv1alpha1.Push},
+	}
+	second := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "second"},
+		Spec:       clusterv1alpha1.ClusterSpec{ID: "same-id", SyncMode: clusterv1alpha1.Push},
+	}
+	client := fake.NewSimpleClientset(first, second)
+	clusterInformer := externalversions.NewSharedInformerFactory(client, 0).Cluster().V1alpha1().Clusters()
+	c, err := NewController(client, clusterInformer, member.clientBuilder, 0, nil)
+	if err != nil {
+		t.Fatalf("failed to create controller: %v", err)
+	}
+	for _, cluster := range []*clusterv1alpha1.Cluster{first, second} {
+		if err := clusterInformer.Informer().GetIndexer().Add(cluster); err != nil {
+			t.Fatalf("failed to add cluster to cache: %v", err)
+		}
+	}
+
+	ctx := context.Background()
+	if _, err := c.syncCluster(ctx, first.Name); err != nil {
+		t.Fatalf("failed to sync cluster: %v", err)
+	}
+	updated, err := client.ClusterV1alpha1().Clusters().Get(ctx, first.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+	condition := meta.FindStatusCondition(updated.Status.Conditions, clusterv1alpha1.ClusterConditionDuplicateID)
+	if condition == nil || condition.Status != metav1.ConditionTrue || condition.Reason != clusterIDDuplicated {
+		t.Fatalf("expected DuplicateID condition to be True, got %+v", condition)
+	}
+	if expected := "cluster ID same-id is also used by clusters: second"; condition.Message != expected {
+		t.Errorf("expected message %q, got %q", expected, condition.Message)
+	}
+}
@@ -0,0 +1,193 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	clusterinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/cluster.kubellm.io/v1alpha1"
+	clusterlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/cluster.kubellm.io/v1alpha1"
+)
+
+// HeartbeatMonitorName 是 Pull 模式集群心跳监控的名称
+const HeartbeatMonitorName = "cluster-heartbeat-monitor"
+
+const (
+	// clusterStatusUnknown 表示 kubellm-agent 超过宽限期没有上报状态
+	clusterStatusUnknown = "ClusterStatusUnknown"
+
+	clusterStatusUnknownMessage = "kubellm-agent stopped reporting the cluster status"
+)
+
+// HeartbeatMonitor 监控 Pull 模式集群的心跳。控制面无法直接探测 Pull 模式的集群，它们的状态由 kubellm-agent 上报，
+// agent 失联后 status 便不再变化。status.lastHeartbeatTime 超过 gracePeriod 没有刷新时，
+// 把 Ready 条件设置为 Unknown，污点管理器随之添加 unreachable 污点；agent 恢复后会重新上报 Ready 条件。
+type HeartbeatMonitor struct {
+	client        versioned.Interface
+	clusterLister clusterlisters.ClusterLister
+	clusterSynced cache.InformerSynced
+
+	// gracePeriod 是心跳过期前允许 agent 不上报状态的时间，应当是 agent 上报间隔的数倍
+	gracePeriod time.Duration
+	clock       clock.Clock
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewHeartbeatMonitor 创建 Pull 模式集群的心跳监控
+func NewHeartbeatMonitor(client versioned.Interface, clusterInformer clusterinformers.ClusterInformer, gracePeriod time.Duration) (*HeartbeatMonitor, error) {
+	m := &HeartbeatMonitor{
+		client:        client,
+		clusterLister: clusterInformer.Lister(),
+		clusterSynced: clusterInformer.Informer().HasSynced,
+		gracePeriod:   gracePeriod,
+		clock:         clock.RealClock{},
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: HeartbeatMonitorName},
+		),
+	}
+	_, err := clusterInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			cluster, ok := obj.(*clusterv1alpha1.Cluster)
+			return ok && cluster.Spec.SyncMode == clusterv1alpha1.Pull
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: m.enqueue,
+			// 每次心跳都会更新 status，重新计算下一次检查的时间
+			UpdateFunc: func(_, newObj interface{}) { m.enqueue(newObj) },
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *HeartbeatMonitor) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	m.queue.Add(key)
+}
+
+// Run 启动 workers 个协程处理队列，直到 ctx 结束
+func (m *HeartbeatMonitor) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer m.queue.ShutDown()
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting controller", "controller", HeartbeatMonitorName)
+	defer logger.Info("Shutting down controller", "controller", HeartbeatMonitorName)
+
+	if !cache.WaitForNamedCacheSync(HeartbeatMonitorName, ctx.Done(), m.clusterSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, m.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (m *HeartbeatMonitor) runWorker(ctx context.Context) {
+	for m.processNextWorkItem(ctx) {
+	}
+}
+
+func (m *HeartbeatMonitor) processNextWorkItem(ctx context.Context) bool {
+	key, quit := m.queue.Get()
+	if quit {
+		return false
+	}
+	defer m.queue.Done(key)
+
+	requeueAfter, err := m.syncCluster(ctx, key)
+	if err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "Error checking cluster heartbeat", "cluster", key)
+		m.queue.AddRateLimited(key)
+		return true
+	}
+	m.queue.Forget(key)
+	if requeueAfter > 0 {
+		// 到心跳过期的时间再检查一次，期间的心跳会通过 UpdateFunc 重新入队
+		m.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+// syncCluster 检查集群的心跳，心跳过期时把 Ready 条件设置为 Unknown。
+// 返回值是距离心跳过期的时间，为 0 时不需要重新检查。
+func (m *HeartbeatMonitor) syncCluster(ctx context.Context, name string) (time.Duration, error) {
+	cluster, err := m.clusterLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if !cluster.DeletionTimestamp.IsZero() || cluster.Spec.SyncMode != clusterv1alpha1.Pull {
+		return 0, nil
+	}
+
+	if remaining := m.heartbeatRemaining(cluster); remaining > 0 {
+		return remaining, nil
+	}
+	if isStatusUnknown(cluster) {
+		return 0, nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster = cluster.DeepCopy()
+		setReadyCondition(&cluster.Status, cluster.Generation, metav1.ConditionUnknown, clusterStatusUnknown, clusterStatusUnknownMessage)
+		_, updateErr := m.client.ClusterV1alpha1().Clusters().UpdateStatus(ctx, cluster, metav1.UpdateOptions{})
+		if updateErr == nil || !apierrors.IsConflict(updateErr) {
+			return updateErr
+		}
+
+		latest, err := m.client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get cluster %s: %w", cluster.Name, err)
+		}
+		// 冲突通常意味着 agent 刚刚上报了心跳，此时不再修改
+		if m.heartbeatRemaining(latest) > 0 || isStatusUnknown(latest) {
+			return nil
+		}
+		cluster = latest
+		return updateErr
+	})
+	if err != nil {
+		return 0, err
+	}
+	klog.FromContext(ctx).Info("Cluster heartbeat expired", "cluster", cluster.Name, "gracePeriod", m.gracePeriod)
+	return 0, nil
+}
+
+// heartbeatRemaining 返回距离心跳过期的时间。尚未上报过心跳的集群从创建时开始计算。
+func (m *HeartbeatMonitor) heartbeatRemaining(cluster *clusterv1alpha1.Cluster) time.Duration {
+	lastHeartbeat := cluster.CreationTimestamp
+	if cluster.Status.LastHeartbeatTime != nil {
+		lastHeartbeat = *cluster.Status.LastHeartbeatTime
+	}
+	return lastHeartbeat.Add(m.gracePeriod).Sub(m.clock.Now())
+}
+
+func isStatusUnknown(cluster *clusterv1alpha1.Cluster) bool {
+	condition := meta.FindStatusCondition(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady)
+	return condition != nil && condition.Status == metav1.ConditionUnknown
+}
@@ -0,0 +1,132 @@
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned/fake"
+	"github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions"
+)
+
+func TestHeartbeatMonitor(t *testing.T) {
+	const gracePeriod = 40 * time.Second
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	readyCondition := func(status metav1.ConditionStatus, reason string) []metav1.Condition {
+		return []metav1.Condition{{Type: clusterv1alpha1.ClusterConditionReady, Status: status, Reason: reason}}
+	}
+	timeAgo := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(-d))
+		return &t
+	}
+
+	tests := []struct {
+		name          string
+		syncMode      clusterv1alpha1.ClusterSyncMode
+		created       time.Duration
+		lastHeartbeat *metav1.Time
+		conditions    []metav1.Condition
+		// expectedReason 为空表示 Ready 条件不应被修改
+		expectedReason  string
+		expectedRequeue time.Duration
+	}{
+		{
+			name:            "recent heartbeat",
+			syncMode:        clusterv1alpha1.Pull,
+			created:         time.Hour,
+			lastHeartbeat:   timeAgo(10 * time.Second),
+			conditions:      readyCondition(metav1.ConditionTrue, clusterReady),
+			expectedRequeue: 30 * time.Second,
+		},
+		{
+			name:           "expired heartbeat",
+			syncMode:       clusterv1alpha1.Pull,
+			created:        time.Hour,
+			lastHeartbeat:  timeAgo(gracePeriod),
+			conditions:     readyCondition(metav1.ConditionTrue, clusterReady),
+			expectedReason: clusterStatusUnknown,
+		},
+		{
+			name:           "expired heartbeat of a not ready cluster",
+			syncMode:       clusterv1alpha1.Pull,
+			created:        time.Hour,
+			lastHeartbeat:  timeAgo(time.Minute),
+			conditions:     readyCondition(metav1.ConditionFalse, clusterNotReady),
+			expectedReason: clusterStatusUnknown,
+		},
+		{
+			name:            "new cluster without heartbeat",
+			syncMode:        clusterv1alpha1.Pull,
+			created:         15 * time.Second,
+			expectedRequeue: 25 * time.Second,
+		},
+		{
+			name:           "agent never reported",
+			syncMode:       clusterv1alpha1.Pull,
+			created:        time.Hour,
+			expectedReason: clusterStatusUnknown,
+		},
+		{
+			name:          "already unknown",
+			syncMode:      clusterv1alpha1.Pull,
+			created:       time.Hour,
+			lastHeartbeat: timeAgo(time.Minute),
+			conditions:    readyCondition(metav1.ConditionUnknown, clusterNotReachable),
+		},
+		{
+			name:       "push mode cluster is ignored",
+			syncMode:   clusterv1alpha1.Push,
+			created:    time.Hour,
+			conditions: readyCondition(metav1.ConditionTrue, clusterReady),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member", CreationTimestamp: metav1.NewTime(now.Add(-tt.created))},
+				Spec:       clusterv1alpha1.ClusterSpec{SyncMode: tt.syncMode},
+				Status:     clusterv1alpha1.ClusterStatus{LastHeartbeatTime: tt.lastHeartbeat, Conditions: tt.conditions},
+			}
+			client := fake.NewSimpleClientset(cluster)
+			clusterInformer := externalversions.NewSharedInformerFactory(client, 0).Cluster().V1alpha1().Clusters()
+			m, err := NewHeartbeatMonitor(client, clusterInformer, gracePeriod)
+			if err != nil {
+				t.Fatalf("failed to create heartbeat monitor: %v", err)
+			}
+			m.clock = testingclock.NewFakeClock(now)
+			if err := clusterInformer.Informer().GetIndexer().Add(cluster); err != nil {
+				t.Fatalf("failed to add cluster to cache: %v", err)
+			}
+
+			ctx := context.Background()
+			requeue, err := m.syncCluster(ctx, cluster.Name)
+			if err != nil {
+				t.Fatalf("failed to sync cluster: %v", err)
+			}
+			if requeue != tt.expectedRequeue {
+				t.Errorf("expected requeue after %v, got %v", tt.expectedRequeue, requeue)
+			}
+
+			updated, err := client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			condition := meta.FindStatusCondition(updated.Status.Conditions, clusterv1alpha1.ClusterConditionReady)
+			if len(tt.expectedReason) == 0 {
+				if len(client.Actions()) > 1 {
+					t.Errorf("expected the cluster not to be updated, got actions %v", client.Actions())
+				}
+				return
+			}
+			if condition == nil || condition.Status != metav1.ConditionUnknown || condition.Reason != tt.expectedReason {
+				t.Errorf("expected Ready condition Unknown/%s, got %+v", tt.expectedReason, condition)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package taint
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	clusterinformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/cluster.kubellm.io/v1alpha1"
+	clusterlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/cluster.kubellm.io/v1alpha1"
+)
+
+// ControllerName 是集群污点管理器的名称
+const ControllerName = "cluster-taint-manager"
+
+var (
+	notReadyTaints = []corev1.Taint{
+		{Key: clusterv1alpha1.TaintClusterNotReady, Effect: corev1.TaintEffectNoSchedule},
+		{Key: clusterv1alpha1.TaintClusterNotReady, Effect: corev1.TaintEffectNoExecute},
+	}
+	unreachableTaints = []corev1.Taint{
+		{Key: clusterv1alpha1.TaintClusterUnreachable, Effect: corev1.TaintEffectNoSchedule},
+		{Key: clusterv1alpha1.TaintClusterUnreachable, Effect: corev1.TaintEffectNoExecute},
+	}
+)
+
+// Controller 根据 Ready 条件维护集群的 not-ready 与 unreachable 污点，
+// 并驱逐不容忍集群 NoExecute 污点的工作负载，tolerationSeconds 到期后才驱逐容忍了污点的工作负载。
+type Controller struct {
+	client        versioned.Interface
+	clusterLister clusterlisters.ClusterLister
+	clusterSynced cache.InformerSynced
+
+	// evictor 为空时只维护污点，不驱逐工作负载
+	evictor WorkloadEvictor
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewController 创建集群污点管理器
+func NewController(client versioned.Interface, clusterInformer clusterinformers.ClusterInformer, evictor WorkloadEvictor) (*Controller, error) {
+	c := &Controller{
+		client:        client,
+		clusterLister: clusterInformer.Lister(),
+		clusterSynced: clusterInformer.Informer().HasSynced,
+		evictor:       evictor,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: ControllerName},
+		),
+	}
+
+	_, err := clusterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldCluster := oldObj.(*clusterv1alpha1.Cluster)
+			newCluster := newObj.(*clusterv1alpha1.Cluster)
+			// 只关心污点与 Ready 条件的变化
+			if !apiequality.Semantic.DeepEqual(oldCluster.Spec.Taints, newCluster.Spec.Taints) ||
+				!apiequality.Semantic.DeepEqual(readyCondition(oldCluster), readyCondition(newCluster)) {
+				c.enqueue(newObj)
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run 启动 workers 个协程处理队列，直到 ctx 结束
+func (c *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting controller", "controller", ControllerName)
+	defer logger.Info("Shutting down controller", "controller", ControllerName)
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), c.clusterSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (c *Controller) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	requeueAfter, err := c.syncCluster(ctx, key)
+	if err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "Error syncing cluster taints", "cluster", key)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	if requeueAfter > 0 {
+		// 还有容忍期未到的工作负载，到期后再次检查
+		c.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+// syncCluster 维护集群污点并驱逐工作负载，返回下一次需要检查的间隔，0 表示无需再次检查
+func (c *Controller) syncCluster(ctx context.Context, name string) (time.Duration, error) {
+	cluster, err := c.clusterLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if !cluster.DeletionTimestamp.IsZero() {
+		return 0, nil
+	}
+
+	if cluster, err = c.syncReadyTaints(ctx, cluster); err != nil {
+		return 0, err
+	}
+	if c.evictor == nil {
+		return 0, nil
+	}
+	return c.evictWorkloads(ctx, cluster)
+}
+
+// syncReadyTaints 根据 Ready 条件添加或移除 not-ready 与 unreachable 污点：
+// Ready 为 False 时添加 not-ready 污点，为 Unknown 时添加 unreachable 污点，为 True 时两者都移除。
+// 没有 Ready 条件的集群尚未被状态控制器处理过，不做修改。
+func (c *Controller) syncReadyTaints(ctx context.Context, cluster *clusterv1alpha1.Cluster) (*clusterv1alpha1.Cluster, error) {
+	ready := readyCondition(cluster)
+	if ready == nil {
+		return cluster, nil
+	}
+
+	var toAdd, toRemove []corev1.Taint
+	switch ready.Status {
+	case metav1.ConditionTrue:
+		toRemove = append(append(toRemove, notReadyTaints...), unreachableTaints...)
+	case metav1.ConditionFalse:
+		toAdd, toRemove = notReadyTaints, unreachableTaints
+	default:
+		toAdd, toRemove = unreachableTaints, notReadyTaints
+	}
+
+	taints := updateTaints(cluster.Spec.Taints, toAdd, toRemove)
+	if apiequality.Semantic.DeepEqual(taints, cluster.Spec.Taints) {
+		return cluster, nil
+	}
+
+	var updated *clusterv1alpha1.Cluster
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster = cluster.DeepCopy()
+		cluster.Spec.Taints = updateTaints(cluster.Spec.Taints, toAdd, toRemove)
+		var updateErr error
+		updated, updateErr = c.client.ClusterV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		if updateErr == nil || !apierrors.IsConflict(updateErr) {
+			return updateErr
+		}
+		latest, err := c.client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		cluster = latest
+		return updateErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	klog.FromContext(ctx).Info("Updated cluster taints", "cluster", cluster.Name, "readyStatus", ready.Status)
+	return updated, nil
+}
+
+// evictWorkloads 驱逐不容忍集群 NoExecute 污点的工作负载，返回最近一次容忍到期的间隔
+func (c *Controller) evictWorkloads(ctx context.Context, cluster *clusterv1alpha1.Cluster) (time.Duration, error) {
+	if !hasNoExecuteTaint(cluster.Spec.Taints) {
+		return 0, nil
+	}
+
+	workloads, err := c.evictor.ListPlacedWorkloads(ctx, cluster.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	logger := klog.FromContext(ctx)
+	now := time.Now()
+	var requeueAfter time.Duration
+	for _, workload := range workloads {
+		evictAt, ok := evictionTime(cluster.Spec.Taints, workload.Tolerations(), now)
+		if !ok {
+			continue
+		}
+		if wait := evictAt.Sub(now); wait > 0 {
+			if requeueAfter == 0 || wait < requeueAfter {
+				requeueAfter = wait
+			}
+			continue
+		}
+
+		if err := c.evictor.Evict(ctx, cluster.Name, workload); err != nil {
+			return 0, err
+		}
+		logger.Info("Evicted workload from tainted cluster", "cluster", cluster.Name, "workload", workload.Key())
+	}
+	return requeueAfter, nil
+}
+
+// updateTaints 返回在 taints 中添加 toAdd、移除 toRemove 后的结果，已存在的污点保持不变
+func updateTaints(taints, toAdd, toRemove []corev1.Taint) []corev1.Taint {
+	var result []corev1.Taint
+	for _, taint := range taints {
+		if !containsTaint(toRemove, &taint) {
+			result = append(result, taint)
+		}
+	}
+	now := metav1.Now()
+	for _, taint := range toAdd {
+		if containsTaint(result, &taint) {
+			continue
+		}
+		if taint.Effect == corev1.TaintEffectNoExecute {
+			taint.TimeAdded = &now
+		}
+		result = append(result, taint)
+	}
+	return result
+}
+
+func containsTaint(taints []corev1.Taint, taint *corev1.Taint) bool {
+	for i := range taints {
+		if taints[i].MatchTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasNoExecuteTaint(taints []corev1.Taint) bool {
+	for _, taint := range taints {
+		if taint.Effect == corev1.TaintEffectNoExecute {
+			return true
+		}
+	}
+	return false
+}
+
+func readyCondition(cluster *clusterv1alpha1.Cluster) *metav1.Condition {
+	return meta.FindStatusCondition(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady)
+}
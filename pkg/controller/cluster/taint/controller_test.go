@@ -0,0 +1,292 @@
+package taint
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned/fake"
+	"github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions"
+)
+
+func TestEvictionTime(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	added := metav1.NewTime(now.Add(-time.Minute))
+	unreachable := corev1.Taint{Key: clusterv1alpha1.TaintClusterUnreachable, Effect: corev1.TaintEffectNoExecute, TimeAdded: &added}
+	notReady := corev1.Taint{Key: clusterv1alpha1.TaintClusterNotReady, Effect: corev1.TaintEffectNoExecute, TimeAdded: &added}
+	noSchedule := corev1.Taint{Key: clusterv1alpha1.TaintClusterUnreachable, Effect: corev1.TaintEffectNoSchedule}
+	tolerate := func(key string, seconds *int64) corev1.Toleration {
+		return corev1.Toleration{Key: key, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: seconds}
+	}
+
+	tests := []struct {
+		name        string
+		taints      []corev1.Taint
+		tolerations []corev1.Toleration
+		expectedAt  time.Time
+		expectedOK  bool
+	}{
+		{
+			name:   "no taints",
+			taints: nil,
+		},
+		{
+			name:   "NoSchedule taints are ignored",
+			taints: []corev1.Taint{noSchedule},
+		},
+		{
+			name:       "not tolerated",
+			taints:     []corev1.Taint{unreachable},
+			expectedAt: now,
+			expectedOK: true,
+		},
+		{
+			name:        "tolerated forever",
+			taints:      []corev1.Taint{unreachable},
+			tolerations: []corev1.Toleration{tolerate(clusterv1alpha1.TaintClusterUnreachable, nil)},
+		},
+		{
+			name:        "tolerated for a while",
+			taints:      []corev1.Taint{unreachable},
+			tolerations: []corev1.Toleration{tolerate(clusterv1alpha1.TaintClusterUnreachable, ptr.To[int64](300))},
+			expectedAt:  added.Add(300 * time.Second),
+			expectedOK:  true,
+		},
+		{
+			name:        "negative toleration seconds evict at once",
+			taints:      []corev1.Taint{unreachable},
+			tolerations: []corev1.Toleration{tolerate(clusterv1alpha1.TaintClusterUnreachable, ptr.To[int64](-1))},
+			expectedAt:  added.Time,
+			expectedOK:  true,
+		},
+		{
+			name:   "shortest toleration wins",
+			taints: []corev1.Taint{unreachable, notReady},
+			tolerations: []corev1.Toleration{
+				tolerate(clusterv1alpha1.TaintClusterUnreachable, ptr.To[int64](600)),
+				tolerate(clusterv1alpha1.TaintClusterNotReady, ptr.To[int64](60)),
+			},
+			expectedAt: added.Add(60 * time.Second),
+			expectedOK: true,
+		},
+		{
+			name:        "one of the taints not tolerated",
+			taints:      []corev1.Taint{unreachable, notReady},
+			tolerations: []corev1.Toleration{tolerate(clusterv1alpha1.TaintClusterUnreachable, ptr.To[int64](600))},
+			expectedAt:  now,
+			expectedOK:  true,
+		},
+		{
+			name:        "taint without time added counts from now",
+			taints:      []corev1.Taint{{Key: clusterv1alpha1.TaintClusterUnreachable, Effect: corev1.TaintEffectNoExecute}},
+			tolerations: []corev1.Toleration{tolerate(clusterv1alpha1.TaintClusterUnreachable, ptr.To[int64](30))},
+			expectedAt:  now.Add(30 * time.Second),
+			expectedOK:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, ok := evictionTime(tt.taints, tt.tolerations, now)
+			if ok != tt.expectedOK || !at.Equal(tt.expectedAt) {
+				t.Errorf("expected (%v, %v), got (%v, %v)", tt.expectedAt, tt.expectedOK, at, ok)
+			}
+		})
+	}
+}
+
+// fakeWorkload 与 fakeEvictor 记录污点管理器驱逐的工作负载
+type fakeWorkload struct {
+	key         string
+	tolerations []corev1.Toleration
+}
+
+func (w *fakeWorkload) Key() string                      { return w.key }
+func (w *fakeWorkload) Tolerations() []corev1.Toleration { return w.tolerations }
+
+type fakeEvictor struct {
+	sync.Mutex
+	workloads []Workload
+	evicted   []string
+}
+
+func (e *fakeEvictor) ListPlacedWorkloads(context.Context, string) ([]Workload, error) {
+	return e.workloads, nil
+}
+
+func (e *fakeEvictor) Evict(_ context.Context, _ string, workload Workload) error {
+	e.Lock()
+	defer e.Unlock()
+	e.evicted = append(e.evicted, workload.Key())
+	return nil
+}
+
+func TestSyncCluster(t *testing.T) {
+	tolerate := func(seconds *int64) []corev1.Toleration {
+		return []corev1.Toleration{{Key: clusterv1alpha1.TaintClusterUnreachable, Operator: corev1.TolerationOpExists,
+			Effect: corev1.TaintEffectNoExecute, TolerationSeconds: seconds}}
+	}
+	workloads := []Workload{
+		&fakeWorkload{key: "default/intolerant"},
+		&fakeWorkload{key: "default/tolerant", tolerations: tolerate(ptr.To[int64](300))},
+		&fakeWorkload{key: "default/forever", tolerations: tolerate(nil)},
+	}
+
+	tests := []struct {
+		name            string
+		readyStatus     metav1.ConditionStatus
+		expectedTaints  []string
+		expectedEvicted []string
+		expectRequeue   bool
+	}{
+		{
+			name:            "unreachable cluster",
+			readyStatus:     metav1.ConditionUnknown,
+			expectedTaints:  []string{clusterv1alpha1.TaintClusterUnreachable, clusterv1alpha1.TaintClusterUnreachable},
+			expectedEvicted: []string{"default/intolerant"},
+			expectRequeue:   true,
+		},
+		{
+			name:            "not ready cluster",
+			readyStatus:     metav1.ConditionFalse,
+			expectedTaints:  []string{clusterv1alpha1.TaintClusterNotReady, clusterv1alpha1.TaintClusterNotReady},
+			expectedEvicted: []string{"default/intolerant", "default/tolerant", "default/forever"},
+		},
+		{
+			name:        "ready cluster",
+			readyStatus: metav1.ConditionTrue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &clusterv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "member"},
+				Status: clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{
+					{Type: clusterv1alpha1.ClusterConditionReady, Status: tt.readyStatus},
+				}},
+			}
+			client := fake.NewSimpleClientset(cluster)
+			clusterInformer := externalversions.NewSharedInformerFactory(client, 0).Cluster().V1alpha1().Clusters()
+			evictor := &fakeEvictor{workloads: workloads}
+			c, err := NewController(client, clusterInformer, evictor)
+			if err != nil {
+				t.Fatalf("failed to create controller: %v", err)
+			}
+			if err := clusterInformer.Informer().GetIndexer().Add(cluster); err != nil {
+				t.Fatalf("failed to add cluster to cache: %v", err)
+			}
+
+			ctx := context.Background()
+			requeue, err := c.syncCluster(ctx, cluster.Name)
+			if err != nil {
+				t.Fatalf("failed to sync cluster: %v", err)
+			}
+			if tt.expectRequeue != (requeue > 0) || requeue > 300*time.Second {
+				t.Errorf("unexpected requeue after %v", requeue)
+			}
+
+			updated, err := client.ClusterV1alpha1().Clusters().Get(ctx, cluster.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			var taints []string
+			for _, taint := range updated.Spec.Taints {
+				taints = append(taints, taint.Key)
+				if taint.Effect == corev1.TaintEffectNoExecute && taint.TimeAdded == nil {
+					t.Errorf("expected NoExecute taint %s to record the time added", taint.Key)
+				}
+			}
+			if !slices.Equal(taints, tt.expectedTaints) {
+				t.Errorf("expected taints %v, got %v", tt.expectedTaints, taints)
+			}
+			if !slices.Equal(evictor.evicted, tt.expectedEvicted) {
+				t.Errorf("expected evicted workloads %v, got %v", tt.expectedEvicted, evictor.evicted)
+			}
+		})
+	}
+}
+
+func TestDeploymentEvictor(t *testing.T) {
+	newDeployment := func(name string, labels, annotations map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default", Name: name, UID: types.UID("uid-" + name), Labels: labels, Annotations: annotations,
+		}}
+	}
+	modelLabels := map[string]string{LabelModelDeployment: "true"}
+	memberClient := kubefake.NewSimpleClientset(
+		newDeployment("llama", modelLabels, nil),
+		newDeployment("qwen", modelLabels, map[string]string{
+			AnnotationClusterTolerations: `[{"key":"cluster.kubellm.io/unreachable","operator":"Exists","effect":"NoExecute","tolerationSeconds":300}]`,
+		}),
+		newDeployment("invalid", modelLabels, map[string]string{AnnotationClusterTolerations: "{"}),
+		newDeployment("other", nil, nil),
+	)
+
+	client := fake.NewSimpleClientset()
+	clusterInformer := externalversions.NewSharedInformerFactory(client, 0).Cluster().V1alpha1().Clusters()
+	for _, cluster := range []*clusterv1alpha1.Cluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "push"}, Spec: clusterv1alpha1.ClusterSpec{SyncMode: clusterv1alpha1.Push}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pull"}, Spec: clusterv1alpha1.ClusterSpec{SyncMode: clusterv1alpha1.Pull}},
+	} {
+		if err := clusterInformer.Informer().GetIndexer().Add(cluster); err != nil {
+			t.Fatalf("failed to add cluster to cache: %v", err)
+		}
+	}
+	e := NewDeploymentEvictor(clusterInformer.Lister(), func(*clusterv1alpha1.Cluster) (kubernetes.Interface, error) {
+		return memberClient, nil
+	})
+
+	ctx := context.Background()
+	workloads, err := e.ListPlacedWorkloads(ctx, "push")
+	if err != nil {
+		t.Fatalf("failed to list workloads: %v", err)
+	}
+	tolerations := map[string]int{}
+	for _, workload := range workloads {
+		tolerations[workload.Key()] = len(workload.Tolerations())
+	}
+	if len(tolerations) != 2 || tolerations["default/llama"] != 0 || tolerations["default/qwen"] != 1 {
+		t.Errorf("expected model deployments llama and qwen, got %v", tolerations)
+	}
+
+	if workloads, err := e.ListPlacedWorkloads(ctx, "pull"); err != nil || len(workloads) != 0 {
+		t.Errorf("expected no workloads in Pull mode clusters, got %v, %v", workloads, err)
+	}
+
+	for _, workload := range workloads {
+		if workload.Key() != "default/llama" {
+			continue
+		}
+		if err := e.Evict(ctx, "push", workload); err != nil {
+			t.Fatalf("failed to evict workload: %v", err)
+		}
+		// 重复驱逐已删除的部署不报错
+		if err := e.Evict(ctx, "push", workload); err != nil {
+			t.Fatalf("failed to evict workload again: %v", err)
+		}
+	}
+	deployments, err := memberClient.AppsV1().Deployments("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list deployments: %v", err)
+	}
+	for _, deployment := range deployments.Items {
+		if deployment.Name == "llama" {
+			t.Errorf("expected deployment llama to be deleted")
+		}
+	}
+	if len(deployments.Items) != 3 {
+		t.Errorf("expected 3 deployments left, got %d", len(deployments.Items))
+	}
+}
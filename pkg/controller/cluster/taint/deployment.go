@@ -0,0 +1,123 @@
+package taint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	clusterlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/cluster.kubellm.io/v1alpha1"
+)
+
+const (
+	// LabelModelDeployment 标记成员集群中由 kubellm 放置的模型部署，值为 "true"
+	LabelModelDeployment = "cluster.kubellm.io/model-deployment"
+	// AnnotationClusterTolerations 保存模型部署对集群污点的容忍，值为 corev1.Toleration 列表的 JSON。
+	// 它与 Pod 模板中针对节点污点的 tolerations 相互独立。
+	AnnotationClusterTolerations = "cluster.kubellm.io/cluster-tolerations"
+)
+
+// ClientBuilderFunc 根据 Cluster 构造访问成员集群的客户端
+type ClientBuilderFunc func(cluster *clusterv1alpha1.Cluster) (kubernetes.Interface, error)
+
+// DeploymentEvictor 以成员集群中带有 LabelModelDeployment 标签的 Deployment 作为放置到该集群的模型部署，
+// 驱逐时删除该 Deployment。控制面无法直接访问 Pull 模式的集群，这些集群上的工作负载不会被驱逐。
+type DeploymentEvictor struct {
+	clusterLister clusterlisters.ClusterLister
+	clientBuilder ClientBuilderFunc
+}
+
+var _ WorkloadEvictor = &DeploymentEvictor{}
+
+// NewDeploymentEvictor 创建驱逐模型部署的 WorkloadEvictor
+func NewDeploymentEvictor(clusterLister clusterlisters.ClusterLister, clientBuilder ClientBuilderFunc) *DeploymentEvictor {
+	return &DeploymentEvictor{clusterLister: clusterLister, clientBuilder: clientBuilder}
+}
+
+// deploymentWorkload 是成员集群中的一个模型部署
+type deploymentWorkload struct {
+	deployment  *appsv1.Deployment
+	tolerations []corev1.Toleration
+}
+
+func (w *deploymentWorkload) Key() string {
+	return w.deployment.Namespace + "/" + w.deployment.Name
+}
+
+func (w *deploymentWorkload) Tolerations() []corev1.Toleration {
+	return w.tolerations
+}
+
+// ListPlacedWorkloads 列出成员集群中所有命名空间下的模型部署。
+// 容忍无法解析的模型部署会被跳过，避免因为配置错误而被立即驱逐。
+func (e *DeploymentEvictor) ListPlacedWorkloads(ctx context.Context, clusterName string) ([]Workload, error) {
+	client, err := e.memberClient(clusterName)
+	if client == nil || err != nil {
+		return nil, err
+	}
+	deployments, err := client.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: LabelModelDeployment + "=true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list model deployments in cluster %s: %w", clusterName, err)
+	}
+
+	workloads := make([]Workload, 0, len(deployments.Items))
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		workload := &deploymentWorkload{deployment: deployment}
+		if value, ok := deployment.Annotations[AnnotationClusterTolerations]; ok {
+			if err := json.Unmarshal([]byte(value), &workload.tolerations); err != nil {
+				utilruntime.HandleErrorWithContext(ctx, err, "Invalid cluster tolerations of model deployment",
+					"cluster", clusterName, "deployment", workload.Key())
+				continue
+			}
+		}
+		workloads = append(workloads, workload)
+	}
+	return workloads, nil
+}
+
+// Evict 删除成员集群中的模型部署，以 UID 作为前置条件，避免误删同名的新部署
+func (e *DeploymentEvictor) Evict(ctx context.Context, clusterName string, workload Workload) error {
+	w, ok := workload.(*deploymentWorkload)
+	if !ok {
+		return fmt.Errorf("unexpected workload type %T", workload)
+	}
+	client, err := e.memberClient(clusterName)
+	if client == nil || err != nil {
+		return err
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	err = client.AppsV1().Deployments(w.deployment.Namespace).Delete(ctx, w.deployment.Name, metav1.DeleteOptions{
+		Preconditions:     &metav1.Preconditions{UID: &w.deployment.UID},
+		PropagationPolicy: &propagation,
+	})
+	if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+		return nil
+	}
+	return err
+}
+
+// memberClient 返回访问成员集群的客户端，集群不存在或处于 Pull 模式时返回 nil
+func (e *DeploymentEvictor) memberClient(clusterName string) (kubernetes.Interface, error) {
+	cluster, err := e.clusterLister.Get(clusterName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Spec.SyncMode == clusterv1alpha1.Pull {
+		return nil, nil
+	}
+	return e.clientBuilder(cluster)
+}
@@ -0,0 +1,69 @@
+package taint
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Workload 是被放置到成员集群上的工作负载，例如模型部署
+type Workload interface {
+	// Key 唯一标识一个工作负载，用于日志
+	Key() string
+	// Tolerations 返回工作负载声明的容忍
+	Tolerations() []corev1.Toleration
+}
+
+// WorkloadEvictor 由承载工作负载放置结果的模块实现，污点管理器通过它找到集群上的工作负载并执行驱逐
+type WorkloadEvictor interface {
+	// ListPlacedWorkloads 返回已放置到指定集群上的工作负载
+	ListPlacedWorkloads(ctx context.Context, clusterName string) ([]Workload, error)
+	// Evict 将工作负载从指定集群中驱逐，由调度器重新放置到其他集群
+	Evict(ctx context.Context, clusterName string, workload Workload) error
+}
+
+// evictionTime 计算工作负载在 NoExecute 污点下应被驱逐的时间。
+// 存在未被容忍的污点时立即驱逐；全部污点都被无期限容忍时返回 false；
+// 否则在最早的污点添加时间加上最短的 tolerationSeconds 后驱逐。
+func evictionTime(taints []corev1.Taint, tolerations []corev1.Toleration, now time.Time) (time.Time, bool) {
+	var (
+		evictAt  time.Time
+		hasLimit bool
+	)
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+
+		tolerated := false
+		for j := range tolerations {
+			toleration := &tolerations[j]
+			if !toleration.ToleratesTaint(taint) {
+				continue
+			}
+			tolerated = true
+			if toleration.TolerationSeconds == nil {
+				continue
+			}
+
+			added := now
+			if taint.TimeAdded != nil {
+				added = taint.TimeAdded.Time
+			}
+			seconds := *toleration.TolerationSeconds
+			if seconds < 0 {
+				seconds = 0
+			}
+			at := added.Add(time.Duration(seconds) * time.Second)
+			if !hasLimit || at.Before(evictAt) {
+				evictAt, hasLimit = at, true
+			}
+		}
+		if !tolerated {
+			return now, true
+		}
+	}
+	return evictAt, hasLimit
+}
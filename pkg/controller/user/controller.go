@@ -0,0 +1,218 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/auth/password"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned"
+	"github.com/kubellm-io/kubellm/pkg/generated/clientset/versioned/scheme"
+	iaminformers "github.com/kubellm-io/kubellm/pkg/generated/informers/externalversions/iam.kubellm.io/v1alpha1"
+	iamlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/iam.kubellm.io/v1alpha1"
+)
+
+// ControllerName 是 User 控制器的名称
+const ControllerName = "user-controller"
+
+// Controller 维护 User 的 Status：根据 Spec.LoginDisabled、冷却时间与密码策略维护 LoginDisabled、
+// AuthLimitExceeded 与 PasswordExpired 条件，结合审批流程与管理员写入的 Approved、Locked 条件按优先级推导 State，
+// 并在 State 变化时记录 Event
+type Controller struct {
+	kubeClient kubernetes.Interface
+	client     versioned.Interface
+	userLister iamlisters.UserLister
+	userSynced cache.InformerSynced
+
+	broadcaster record.EventBroadcaster
+	recorder    record.EventRecorder
+
+	// lockoutDuration 是用户进入 AuthLimitExceeded 状态后自动解除限制前的冷却时间
+	lockoutDuration time.Duration
+	// passwordPolicy 的 MaxAge 决定密码的过期时间
+	passwordPolicy *password.Policy
+	clock          clock.Clock
+
+	// queue 中的元素为用户名称
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewController 创建 User 控制器，Event 通过 kubeClient 写入核心集群
+func NewController(kubeClient kubernetes.Interface, client versioned.Interface, userInformer iaminformers.UserInformer,
+	lockoutDuration time.Duration, passwordPolicy *password.Policy) (*Controller, error) {
+	broadcaster := record.NewBroadcaster()
+	c := &Controller{
+		kubeClient:      kubeClient,
+		client:          client,
+		recorder:        broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: ControllerName}),
+		broadcaster:     broadcaster,
+		userLister:      userInformer.Lister(),
+		userSynced:      userInformer.Informer().HasSynced,
+		lockoutDuration: lockoutDuration,
+		passwordPolicy:  passwordPolicy,
+		clock:           clock.RealClock{},
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: ControllerName},
+		),
+	}
+
+	_, err := userInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueUser,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.enqueueUser(newObj)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Controller) enqueueUser(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run 启动 workers 个协程处理队列，直到 ctx 结束
+func (c *Controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	c.broadcaster.StartStructuredLogging(3)
+	c.broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: c.kubeClient.CoreV1().Events("")})
+	defer c.broadcaster.Shutdown()
+
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting controller", "controller", ControllerName)
+	defer logger.Info("Shutting down controller", "controller", ControllerName)
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), c.userSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	}
+	<-ctx.Done()
+}
+
+func (c *Controller) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	requeueAfter, err := c.syncUser(ctx, key)
+	if err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "Error syncing user", "user", key)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	if requeueAfter > 0 {
+		// 登录限制或密码尚未到期，到期后再次处理
+		c.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+// syncUser 处理一个用户：先根据 Spec、登录记录与密码策略更新条件，再按优先级由条件推导 State，
+// State 变化时记录 Event。返回下一次需要检查的间隔，0 表示无需再次检查。
+func (c *Controller) syncUser(ctx context.Context, name string) (time.Duration, error) {
+	user, err := c.userLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if !user.DeletionTimestamp.IsZero() {
+		return 0, nil
+	}
+
+	var (
+		now          = c.clock.Now()
+		requeueAfter time.Duration
+		from, to     iamv1alpha1.UserState
+		message      string
+	)
+	updated, err := c.updateStatus(ctx, user, func(user *iamv1alpha1.User) bool {
+		status := user.Status.DeepCopy()
+		requeueAfter = 0
+		for _, sync := range []func(*iamv1alpha1.User, *iamv1alpha1.UserStatus, time.Time) time.Duration{
+			c.syncLoginDisabled, c.syncLockout, c.syncPasswordExpiry,
+		} {
+			if after := sync(user, status, now); after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+				requeueAfter = after
+			}
+		}
+		from = status.State
+		updateState(status, now)
+		to, message = status.State, status.Message
+		if apiequality.Semantic.DeepEqual(status, &user.Status) {
+			return false
+		}
+		user.Status = *status
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	if updated && from != to {
+		c.recordStateChange(ctx, user, from, to, message)
+	}
+	return requeueAfter, nil
+}
+
+// updateStatus 在 user 上执行 mutate 并在有变化时写回 Status，冲突时基于最新的对象重新执行 mutate。
+// mutate 返回 false 表示不需要修改，返回值表示是否写入了新的 Status。
+func (c *Controller) updateStatus(ctx context.Context, user *iamv1alpha1.User, mutate func(user *iamv1alpha1.User) bool) (bool, error) {
+	updated := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		user = user.DeepCopy()
+		if !mutate(user) {
+			return nil
+		}
+		_, updateErr := c.client.IamV1alpha1().Users().UpdateStatus(ctx, user, metav1.UpdateOptions{})
+		if updateErr == nil {
+			updated = true
+			return nil
+		}
+		if !apierrors.IsConflict(updateErr) {
+			return updateErr
+		}
+		latest, err := c.client.IamV1alpha1().Users().Get(ctx, user.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		user = latest
+		return updateErr
+	})
+	return updated, err
+}
@@ -0,0 +1,48 @@
+package user
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+)
+
+// ReasonCooldownElapsed 是冷却时间过后解除登录限制时 AuthLimitExceeded 条件的原因
+const ReasonCooldownElapsed = "CooldownElapsed"
+
+// syncLockout 在冷却时间过后将 kubellm-apiserver 设置的 AuthLimitExceeded 条件改为 False 并清零失败计数，
+// 返回距离解除限制的剩余时间
+func (c *Controller) syncLockout(user *iamv1alpha1.User, status *iamv1alpha1.UserStatus, now time.Time) time.Duration {
+	condition := meta.FindStatusCondition(status.Conditions, iamv1alpha1.UserConditionAuthLimitExceeded)
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return 0
+	}
+	if remaining := unlockTime(status, condition, c.lockoutDuration).Sub(now); remaining > 0 {
+		return remaining
+	}
+
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               iamv1alpha1.UserConditionAuthLimitExceeded,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: user.Generation,
+		LastTransitionTime: metav1.Time{Time: now},
+		Reason:             ReasonCooldownElapsed,
+		Message:            fmt.Sprintf("The login limit was lifted after %s", c.lockoutDuration),
+	})
+	status.FailedLoginAttempts = nil
+	status.FirstFailedLoginTime = nil
+	status.LastFailedLoginTime = nil
+	return 0
+}
+
+// unlockTime 返回用户解除登录限制的时间：从触发限制的那次失败登录起经过 lockoutDuration
+func unlockTime(status *iamv1alpha1.UserStatus, condition *metav1.Condition, lockoutDuration time.Duration) time.Time {
+	since := condition.LastTransitionTime
+	if status.LastFailedLoginTime != nil {
+		since = *status.LastFailedLoginTime
+	}
+	return since.Add(lockoutDuration)
+}
@@ -0,0 +1,54 @@
+package user
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+)
+
+const (
+	// ReasonPasswordExpired 是密码过期时 PasswordExpired 条件的原因
+	ReasonPasswordExpired = "PasswordExpired"
+	// ReasonPasswordValid 是密码未过期或永不过期时 PasswordExpired 条件的原因
+	ReasonPasswordValid = "PasswordValid"
+)
+
+// syncPasswordExpiry 根据 PasswordLastChangedTime 与密码策略的 MaxAge 维护 PasswordExpiryTime 与 PasswordExpired 条件。
+// 密码被修改后过期时间延后，条件随之恢复为 False。
+// 没有 PasswordLastChangedTime 的用户（例如来自外部身份提供者的用户）没有本地密码，不会过期。
+// 返回距离密码过期的剩余时间。
+func (c *Controller) syncPasswordExpiry(user *iamv1alpha1.User, status *iamv1alpha1.UserStatus, now time.Time) time.Duration {
+	var expiry *metav1.Time
+	if changed := status.PasswordLastChangedTime; changed != nil {
+		if t, ok := c.passwordPolicy.ExpiryTime(changed.Time); ok {
+			expiry = &metav1.Time{Time: t}
+		}
+	}
+	status.PasswordExpiryTime = expiry
+
+	condition := metav1.Condition{
+		Type:               iamv1alpha1.UserConditionPasswordExpired,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: user.Generation,
+		LastTransitionTime: metav1.Time{Time: now},
+		Reason:             ReasonPasswordValid,
+		Message:            "The password does not expire",
+	}
+	var remaining time.Duration
+	switch {
+	case expiry == nil:
+	case !now.Before(expiry.Time):
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonPasswordExpired
+		condition.Message = fmt.Sprintf("The password expired at %s and must be changed by an administrator", expiry.UTC().Format(time.RFC3339))
+	default:
+		condition.Message = fmt.Sprintf("The password expires at %s", expiry.UTC().Format(time.RFC3339))
+		remaining = expiry.Sub(now)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	return remaining
+}
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+)
+
+// EventReasonStateChanged 是 User 的 State 变化时记录的 Event 的原因
+const EventReasonStateChanged = "StateChanged"
+
+// stateRule 表示当 Status 中的某个条件满足 match 时，用户处于 state 状态
+type stateRule struct {
+	state         iamv1alpha1.UserState
+	conditionType string
+	match         func(condition *metav1.Condition) bool
+}
+
+func conditionTrue(condition *metav1.Condition) bool {
+	return condition.Status == metav1.ConditionTrue
+}
+
+// stateRules 按优先级从高到低排列，第一个满足的规则决定 State，都不满足时为 Active。
+// 管理员的决定优先于自动触发的限制：被拒绝的注册申请与禁止登录视为 Disabled，其次是管理员锁定与等待审批，
+// 最后是冷却时间过后或修改密码后即可自动恢复的 AuthLimitExceeded 与 PasswordExpired。
+var stateRules = []stateRule{
+	{state: iamv1alpha1.UserDisabled, conditionType: iamv1alpha1.UserConditionLoginDisabled, match: conditionTrue},
+	{state: iamv1alpha1.UserDisabled, conditionType: iamv1alpha1.UserConditionApproved, match: func(condition *metav1.Condition) bool {
+		return condition.Status == metav1.ConditionFalse && condition.Reason == iamv1alpha1.UserReasonRejected
+	}},
+	{state: iamv1alpha1.UserLocked, conditionType: iamv1alpha1.UserConditionLocked, match: conditionTrue},
+	{state: iamv1alpha1.UserPendingApproval, conditionType: iamv1alpha1.UserConditionApproved, match: func(condition *metav1.Condition) bool {
+		return condition.Status == metav1.ConditionFalse
+	}},
+	{state: iamv1alpha1.UserAuthLimitExceeded, conditionType: iamv1alpha1.UserConditionAuthLimitExceeded, match: conditionTrue},
+	{state: iamv1alpha1.UserPasswordExpired, conditionType: iamv1alpha1.UserConditionPasswordExpired, match: conditionTrue},
+}
+
+// updateState 按 stateRules 由条件推导 State，Reason 与 Message 取自决定 State 的条件。
+// LastTransitionTime 只在 State 变化时更新。
+func updateState(status *iamv1alpha1.UserStatus, now time.Time) {
+	state, reason, message := iamv1alpha1.UserActive, "", ""
+	for _, rule := range stateRules {
+		if condition := meta.FindStatusCondition(status.Conditions, rule.conditionType); condition != nil && rule.match(condition) {
+			state, reason, message = rule.state, condition.Reason, condition.Message
+			break
+		}
+	}
+	if status.State != state {
+		status.State = state
+		status.LastTransitionTime = &metav1.Time{Time: now}
+	}
+	status.Reason = reason
+	status.Message = message
+}
+
+// recordStateChange 为 State 的变化记录 Event，进入 Active 以外的状态时为 Warning
+func (c *Controller) recordStateChange(ctx context.Context, user *iamv1alpha1.User, from, to iamv1alpha1.UserState, message string) {
+	if len(from) == 0 {
+		from = "Unknown"
+	}
+	eventType := corev1.EventTypeNormal
+	if to != iamv1alpha1.UserActive {
+		eventType = corev1.EventTypeWarning
+	}
+	if len(message) > 0 {
+		c.recorder.Eventf(user, eventType, EventReasonStateChanged, "User state changed from %s to %s: %s", from, to, message)
+	} else {
+		c.recorder.Eventf(user, eventType, EventReasonStateChanged, "User state changed from %s to %s", from, to)
+	}
+	klog.FromContext(ctx).Info("User state changed", "user", user.Name, "from", from, "to", to, "message", message)
+}
+
+// syncLoginDisabled 使 LoginDisabled 条件反映 Spec.LoginDisabled
+func (c *Controller) syncLoginDisabled(user *iamv1alpha1.User, status *iamv1alpha1.UserStatus, now time.Time) time.Duration {
+	condition := metav1.Condition{
+		Type:               iamv1alpha1.UserConditionLoginDisabled,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: user.Generation,
+		LastTransitionTime: metav1.Time{Time: now},
+		Reason:             "LoginEnabled",
+		Message:            "Login is enabled",
+	}
+	if ptr.Deref(user.Spec.LoginDisabled, false) {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "LoginDisabled"
+		condition.Message = "Login is disabled by the administrator"
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	return 0
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/estimator/pb"
+)
+
+// Resolver 返回集群对应的 estimator 地址，返回空字符串表示该集群没有部署 estimator
+type Resolver func(cluster *clusterv1alpha1.Cluster) string
+
+// ServiceResolver 将集群解析为 <prefix>-<cluster>.<namespace>.svc:<port>，
+// 适用于在控制面中为每个成员集群的 estimator 创建一个 Service 的部署方式
+func ServiceResolver(namespace, prefix string, port int) Resolver {
+	return func(cluster *clusterv1alpha1.Cluster) string {
+		return fmt.Sprintf("%s-%s.%s.svc:%d", prefix, cluster.Name, namespace, port)
+	}
+}
+
+// StaticResolver 将所有集群解析为同一个地址，适用于嵌入 kubellm-controller 的 estimator
+func StaticResolver(address string) Resolver {
+	return func(*clusterv1alpha1.Cluster) string {
+		return address
+	}
+}
+
+// SyncModeResolver 按集群的同步模式选择 Resolver，例如 Push 模式的集群使用嵌入 kubellm-controller 的 estimator，
+// Pull 模式的集群使用运行在成员集群中的 estimator。Resolver 为空时对应模式的集群没有 estimator。
+func SyncModeResolver(push, pull Resolver) Resolver {
+	return func(cluster *clusterv1alpha1.Cluster) string {
+		resolver := push
+		if cluster.Spec.SyncMode == clusterv1alpha1.Pull {
+			resolver = pull
+		}
+		if resolver == nil {
+			return ""
+		}
+		return resolver(cluster)
+	}
+}
+
+// Estimate 是一个集群的估算结果
+type Estimate struct {
+	// Cluster 是集群名称
+	Cluster string
+	// Replicas 是集群中最多还能调度的副本数
+	Replicas int32
+	// Err 是访问 estimator 的错误，非空时 Replicas 来自 GeneralEstimate 的粗略估算
+	Err error
+}
+
+// Client 并发地向各个集群的 estimator 查询容量，供调度器在放置时使用节点级别的实际剩余资源。
+// 与同一个地址的连接会被复用，直到调用 Close。
+type Client struct {
+	resolver    Resolver
+	timeout     time.Duration
+	dialOptions []grpc.DialOption
+
+	lock sync.Mutex
+	// conns 以 estimator 地址为键
+	conns map[string]*grpc.ClientConn
+}
+
+// NewClient 创建 estimator 客户端，timeout 是每个集群单次查询的超时时间，creds 是访问 estimator 使用的传输层凭证，通常由 Credentials 创建
+func NewClient(resolver Resolver, timeout time.Duration, creds credentials.TransportCredentials) *Client {
+	return &Client{
+		resolver:    resolver,
+		timeout:     timeout,
+		dialOptions: []grpc.DialOption{grpc.WithTransportCredentials(creds)},
+		conns:       make(map[string]*grpc.ClientConn),
+	}
+}
+
+// MaxAvailableReplicas 并发查询 template 在每个集群中最多还能调度的副本数，结果与 clusters 一一对应。
+// 没有 estimator、estimator 超时或返回错误的集群会退化为 GeneralEstimate 的粗略估算，并在 Estimate.Err 中记录原因。
+func (c *Client) MaxAvailableReplicas(ctx context.Context, clusters []*clusterv1alpha1.Cluster, template *corev1.PodTemplateSpec) []Estimate {
+	estimates := make([]Estimate, len(clusters))
+	podTemplate, err := json.Marshal(template)
+	if err != nil {
+		for i, cluster := range clusters {
+			estimates[i] = Estimate{Cluster: cluster.Name, Replicas: GeneralEstimate(cluster, template), Err: err}
+		}
+		return estimates
+	}
+
+	var wg sync.WaitGroup
+	for i, cluster := range clusters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			replicas, err := c.maxAvailableReplicas(ctx, cluster, podTemplate)
+			if err != nil {
+				klog.FromContext(ctx).V(2).Info("Falling back to the general estimate", "cluster", cluster.Name, "err", err)
+				replicas = GeneralEstimate(cluster, template)
+			}
+			estimates[i] = Estimate{Cluster: cluster.Name, Replicas: replicas, Err: err}
+		}()
+	}
+	wg.Wait()
+	return estimates
+}
+
+func (c *Client) maxAvailableReplicas(ctx context.Context, cluster *clusterv1alpha1.Cluster, podTemplate []byte) (int32, error) {
+	address := c.resolver(cluster)
+	if len(address) == 0 {
+		return 0, fmt.Errorf("no estimator for cluster %s", cluster.Name)
+	}
+	conn, err := c.conn(address)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := pb.NewEstimatorClient(conn).MaxAvailableReplicas(ctx, &pb.MaxAvailableReplicasRequest{
+		Cluster:     cluster.Name,
+		PodTemplate: podTemplate,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query estimator %s for cluster %s: %w", address, cluster.Name, err)
+	}
+	return resp.MaxReplicas, nil
+}
+
+// conn 返回到 address 的连接，连接在第一次请求时才会真正建立，断开后由 gRPC 自动重连
+func (c *Client) conn(address string) (*grpc.ClientConn, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if conn, ok := c.conns[address]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.NewClient(address, c.dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection to estimator %s: %w", address, err)
+	}
+	c.conns[address] = conn
+	return conn, nil
+}
+
+// Close 关闭所有连接
+func (c *Client) Close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for address, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			klog.ErrorS(err, "Failed to close connection to estimator", "address", address)
+		}
+		delete(c.conns, address)
+	}
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	resourcehelper "k8s.io/component-helpers/resource"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// GeneralEstimate 根据 Cluster 的 ResourceSummary 与 ResourceModels 粗略估算 template 最多还能调度的副本数，
+// 仅在 estimator 不可用时使用，这也是资源模型唯一参与估算的地方。它不考虑污点与节点亲和性，结果可能偏大。
+//
+// 集群剩余资源为 Allocatable - Allocated - Allocating。资源模型描述了 template 请求的所有资源时，
+// 再按每个等级的节点数与该等级区间的 Min 估算，并取两者中较小的值。
+func GeneralEstimate(cluster *clusterv1alpha1.Cluster, template *corev1.PodTemplateSpec) int32 {
+	summary := cluster.Status.ResourceSummary
+	if summary == nil {
+		return 0
+	}
+	pod := &corev1.Pod{ObjectMeta: template.ObjectMeta, Spec: template.Spec}
+	requests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+
+	available := summary.Allocatable.DeepCopy()
+	for _, used := range []corev1.ResourceList{summary.Allocated, summary.Allocating} {
+		for name, quantity := range used {
+			if value, ok := available[name]; ok {
+				value.Sub(quantity)
+				available[name] = value
+			}
+		}
+	}
+	// 除资源请求外，每个副本还占用一个 Pod 配额
+	replicas := replicasOf(available, requests)
+	if podCapacity, ok := available[corev1.ResourcePods]; ok {
+		replicas = min(replicas, max(podCapacity.Value(), 0))
+	}
+
+	if modelReplicas, ok := modelingEstimate(cluster.Spec.ResourceModels, summary.AllocatableModelings, requests); ok {
+		replicas = min(replicas, modelReplicas)
+	}
+	return int32(min(replicas, math.MaxInt32))
+}
+
+// modelingEstimate 按每个等级中节点的最小剩余资源估算副本数，资源模型没有描述 requests 中的某个资源时返回 false
+func modelingEstimate(models []clusterv1alpha1.ResourceModel, modelings []clusterv1alpha1.AllocatableModeling,
+	requests corev1.ResourceList) (int64, bool) {
+	if len(models) == 0 || len(modelings) == 0 {
+		return 0, false
+	}
+	lowest := models[0].Grade
+	for _, model := range models {
+		lowest = min(lowest, model.Grade)
+	}
+	minimums := make(map[uint]corev1.ResourceList, len(models))
+	for _, model := range models {
+		minimum := make(corev1.ResourceList, len(model.Ranges))
+		for _, r := range model.Ranges {
+			// 与 modeling.Modeling 一致，第一个等级的 Min 视为 0
+			if model.Grade == lowest {
+				minimum[r.Name] = resource.Quantity{}
+			} else {
+				minimum[r.Name] = r.Min
+			}
+		}
+		minimums[model.Grade] = minimum
+	}
+	for name, request := range requests {
+		if request.IsZero() {
+			continue
+		}
+		if _, ok := minimums[lowest][name]; !ok {
+			return 0, false
+		}
+	}
+
+	var replicas int64
+	for _, modeling := range modelings {
+		minimum, ok := minimums[modeling.Grade]
+		if !ok || modeling.Count == 0 {
+			continue
+		}
+		replicas += int64(modeling.Count) * replicasOf(minimum, requests)
+		if replicas >= math.MaxInt32 {
+			return math.MaxInt32, true
+		}
+	}
+	return replicas, true
+}
+
+// replicasOf 返回 available 最多能满足多少份 requests，requests 中没有非零请求时返回 math.MaxInt32
+func replicasOf(available, requests corev1.ResourceList) int64 {
+	replicas := int64(math.MaxInt32)
+	for name, request := range requests {
+		if request.IsZero() {
+			continue
+		}
+		quantity := available[name]
+		replicas = min(replicas, max(quantity.MilliValue(), 0)/request.MilliValue())
+	}
+	return replicas
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: estimator.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MaxAvailableReplicasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cluster 是成员集群的名称。嵌入 kubellm-controller 时用于选择集群，独立运行时必须与 estimator 所在的集群一致
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// pod_template 是 JSON 编码的 k8s.io/api/core/v1.PodTemplateSpec，
+	// 估算时使用其中的资源请求、nodeSelector、必需的节点亲和性以及容忍
+	PodTemplate   []byte `protobuf:"bytes,2,opt,name=pod_template,json=podTemplate,proto3" json:"pod_template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaxAvailableReplicasRequest) Reset() {
+	*x = MaxAvailableReplicasRequest{}
+	mi := &file_estimator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaxAvailableReplicasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxAvailableReplicasRequest) ProtoMessage() {}
+
+func (x *MaxAvailableReplicasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_estimator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxAvailableReplicasRequest.ProtoReflect.Descriptor instead.
+func (*MaxAvailableReplicasRequest) Descriptor() ([]byte, []int) {
+	return file_estimator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MaxAvailableReplicasRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *MaxAvailableReplicasRequest) GetPodTemplate() []byte {
+	if x != nil {
+		return x.PodTemplate
+	}
+	return nil
+}
+
+type MaxAvailableReplicasResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_replicas 是所有节点上最多还能调度的副本数之和
+	MaxReplicas   int32 `protobuf:"varint,1,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaxAvailableReplicasResponse) Reset() {
+	*x = MaxAvailableReplicasResponse{}
+	mi := &file_estimator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaxAvailableReplicasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaxAvailableReplicasResponse) ProtoMessage() {}
+
+func (x *MaxAvailableReplicasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_estimator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaxAvailableReplicasResponse.ProtoReflect.Descriptor instead.
+func (*MaxAvailableReplicasResponse) Descriptor() ([]byte, []int) {
+	return file_estimator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MaxAvailableReplicasResponse) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+var File_estimator_proto protoreflect.FileDescriptor
+
+var file_estimator_proto_rawDesc = string([]byte{
+	0x0a, 0x0f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x1a, 0x6b, 0x75, 0x62, 0x65, 0x6c, 0x6c, 0x6d, 0x2e, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x5a, 0x0a,
+	0x1b, 0x4d, 0x61, 0x78, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x64, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x6f,
+	0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x41, 0x0a, 0x1c, 0x4d, 0x61, 0x78,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x32, 0x97, 0x01, 0x0a,
+	0x09, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x4d,
+	0x61, 0x78, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x12, 0x37, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x6c, 0x6c, 0x6d, 0x2e, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x61, 0x78, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6b,
+	0x75, 0x62, 0x65, 0x6c, 0x6c, 0x6d, 0x2e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x78, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x6c, 0x6c, 0x6d, 0x2d, 0x69, 0x6f, 0x2f,
+	0x6b, 0x75, 0x62, 0x65, 0x6c, 0x6c, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_estimator_proto_rawDescOnce sync.Once
+	file_estimator_proto_rawDescData []byte
+)
+
+func file_estimator_proto_rawDescGZIP() []byte {
+	file_estimator_proto_rawDescOnce.Do(func() {
+		file_estimator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_estimator_proto_rawDesc), len(file_estimator_proto_rawDesc)))
+	})
+	return file_estimator_proto_rawDescData
+}
+
+var file_estimator_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_estimator_proto_goTypes = []any{
+	(*MaxAvailableReplicasRequest)(nil),  // 0: kubellm.estimator.v1alpha1.MaxAvailableReplicasRequest
+	(*MaxAvailableReplicasResponse)(nil), // 1: kubellm.estimator.v1alpha1.MaxAvailableReplicasResponse
+}
+var file_estimator_proto_depIdxs = []int32{
+	0, // 0: kubellm.estimator.v1alpha1.Estimator.MaxAvailableReplicas:input_type -> kubellm.estimator.v1alpha1.MaxAvailableReplicasRequest
+	1, // 1: kubellm.estimator.v1alpha1.Estimator.MaxAvailableReplicas:output_type -> kubellm.estimator.v1alpha1.MaxAvailableReplicasResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_estimator_proto_init() }
+func file_estimator_proto_init() {
+	if File_estimator_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_estimator_proto_rawDesc), len(file_estimator_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_estimator_proto_goTypes,
+		DependencyIndexes: file_estimator_proto_depIdxs,
+		MessageInfos:      file_estimator_proto_msgTypes,
+	}.Build()
+	File_estimator_proto = out.File
+	file_estimator_proto_goTypes = nil
+	file_estimator_proto_depIdxs = nil
+}
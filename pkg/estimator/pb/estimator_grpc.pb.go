@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: estimator.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Estimator_MaxAvailableReplicas_FullMethodName = "/kubellm.estimator.v1alpha1.Estimator/MaxAvailableReplicas"
+)
+
+// EstimatorClient is the client API for Estimator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Estimator 根据成员集群中节点的实际剩余资源估算还能调度多少个副本。
+// 它既可以作为独立进程运行在每个成员集群中，也可以嵌入 kubellm-controller 为 Push 模式的集群提供服务。
+type EstimatorClient interface {
+	// MaxAvailableReplicas 返回 pod_template 在集群中最多还能调度的副本数
+	MaxAvailableReplicas(ctx context.Context, in *MaxAvailableReplicasRequest, opts ...grpc.CallOption) (*MaxAvailableReplicasResponse, error)
+}
+
+type estimatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEstimatorClient(cc grpc.ClientConnInterface) EstimatorClient {
+	return &estimatorClient{cc}
+}
+
+func (c *estimatorClient) MaxAvailableReplicas(ctx context.Context, in *MaxAvailableReplicasRequest, opts ...grpc.CallOption) (*MaxAvailableReplicasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaxAvailableReplicasResponse)
+	err := c.cc.Invoke(ctx, Estimator_MaxAvailableReplicas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EstimatorServer is the server API for Estimator service.
+// All implementations must embed UnimplementedEstimatorServer
+// for forward compatibility.
+//
+// Estimator 根据成员集群中节点的实际剩余资源估算还能调度多少个副本。
+// 它既可以作为独立进程运行在每个成员集群中，也可以嵌入 kubellm-controller 为 Push 模式的集群提供服务。
+type EstimatorServer interface {
+	// MaxAvailableReplicas 返回 pod_template 在集群中最多还能调度的副本数
+	MaxAvailableReplicas(context.Context, *MaxAvailableReplicasRequest) (*MaxAvailableReplicasResponse, error)
+	mustEmbedUnimplementedEstimatorServer()
+}
+
+// UnimplementedEstimatorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEstimatorServer struct{}
+
+func (UnimplementedEstimatorServer) MaxAvailableReplicas(context.Context, *MaxAvailableReplicasRequest) (*MaxAvailableReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxAvailableReplicas not implemented")
+}
+func (UnimplementedEstimatorServer) mustEmbedUnimplementedEstimatorServer() {}
+func (UnimplementedEstimatorServer) testEmbeddedByValue()                   {}
+
+// UnsafeEstimatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EstimatorServer will
+// result in compilation errors.
+type UnsafeEstimatorServer interface {
+	mustEmbedUnimplementedEstimatorServer()
+}
+
+func RegisterEstimatorServer(s grpc.ServiceRegistrar, srv EstimatorServer) {
+	// If the following call pancis, it indicates UnimplementedEstimatorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Estimator_ServiceDesc, srv)
+}
+
+func _Estimator_MaxAvailableReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaxAvailableReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstimatorServer).MaxAvailableReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Estimator_MaxAvailableReplicas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstimatorServer).MaxAvailableReplicas(ctx, req.(*MaxAvailableReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Estimator_ServiceDesc is the grpc.ServiceDesc for Estimator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Estimator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kubellm.estimator.v1alpha1.Estimator",
+	HandlerType: (*EstimatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MaxAvailableReplicas",
+			Handler:    _Estimator_MaxAvailableReplicas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "estimator.proto",
+}
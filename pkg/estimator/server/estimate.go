@@ -0,0 +1,95 @@
+package server
+
+import (
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	resourcehelper "k8s.io/component-helpers/resource"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+)
+
+// maxAvailableReplicas 按每个节点的剩余资源估算 pod 最多还能调度的副本数。
+// 与调度器的 NodeUnschedulable、TaintToleration、NodeAffinity 与 NodeResourcesFit 插件保持一致：
+// 跳过不可调度、存在未容忍的 NoSchedule/NoExecute 污点或不满足 nodeSelector 与必需节点亲和性的节点，
+// 其余节点上的副本数由剩余资源（包括 pods 数量）与请求之比的最小值决定。
+// 它不使用 Cluster 的 ResourceModels：资源模型只用于 estimator 不可用时的 GeneralEstimate。
+func maxAvailableReplicas(nodes []*corev1.Node, pods []*corev1.Pod, pod *corev1.Pod) int32 {
+	requests := resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{})
+	affinity := nodeaffinity.GetRequiredNodeAffinity(pod)
+
+	nodeRequested, nodePods := getNodeRequested(pods)
+	var replicas int64
+	for _, node := range nodes {
+		if !nodeFits(node, pod, affinity) {
+			continue
+		}
+		replicas += nodeReplicas(node, requests, nodeRequested[node.Name], nodePods[node.Name])
+		if replicas >= math.MaxInt32 {
+			return math.MaxInt32
+		}
+	}
+	return int32(replicas)
+}
+
+// nodeFits 判断 pod 是否可以调度到 node，不考虑资源
+func nodeFits(node *corev1.Node, pod *corev1.Pod, affinity nodeaffinity.RequiredNodeAffinity) bool {
+	if node.Spec.Unschedulable && !corev1helpers.TolerationsTolerateTaint(pod.Spec.Tolerations, &corev1.Taint{
+		Key:    corev1.TaintNodeUnschedulable,
+		Effect: corev1.TaintEffectNoSchedule,
+	}) {
+		return false
+	}
+	if _, untolerated := corev1helpers.FindMatchingUntoleratedTaint(node.Spec.Taints, pod.Spec.Tolerations, func(t *corev1.Taint) bool {
+		return t.Effect == corev1.TaintEffectNoSchedule || t.Effect == corev1.TaintEffectNoExecute
+	}); untolerated {
+		return false
+	}
+	// 节点亲和性不合法时调度器同样无法调度该 pod
+	match, err := affinity.Match(node)
+	return err == nil && match
+}
+
+// nodeReplicas 返回 node 上还能容纳的副本数，requested 与 podCount 是节点上已调度 Pod 的资源请求之和与数量
+func nodeReplicas(node *corev1.Node, requests, requested corev1.ResourceList, podCount int64) int64 {
+	allocatable := node.Status.Allocatable
+	replicas := allocatable.Pods().Value() - podCount
+	for name, request := range requests {
+		if request.IsZero() {
+			continue
+		}
+		capacity, ok := allocatable[name]
+		if !ok {
+			return 0
+		}
+		used := requested[name]
+		// 使用 MilliValue 以免丢失 cpu 等小数资源的精度
+		available := capacity.MilliValue() - used.MilliValue()
+		replicas = min(replicas, available/request.MilliValue())
+	}
+	return max(replicas, 0)
+}
+
+// getNodeRequested 统计每个节点上已调度 Pod 的资源请求之和以及 Pod 数量，pods 中不应包含已结束的 Pod
+func getNodeRequested(pods []*corev1.Pod) (map[string]corev1.ResourceList, map[string]int64) {
+	nodeRequested := make(map[string]corev1.ResourceList)
+	nodePods := make(map[string]int64)
+	for _, pod := range pods {
+		nodeName := pod.Spec.NodeName
+		if len(nodeName) == 0 {
+			continue
+		}
+		nodePods[nodeName]++
+		requested, ok := nodeRequested[nodeName]
+		if !ok {
+			requested = make(corev1.ResourceList)
+			nodeRequested[nodeName] = requested
+		}
+		for name, quantity := range resourcehelper.PodRequests(pod, resourcehelper.PodResourcesOptions{}) {
+			value := requested[name]
+			value.Add(quantity)
+			requested[name] = value
+		}
+	}
+	return nodeRequested, nodePods
+}
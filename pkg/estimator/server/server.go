@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kubellm-io/kubellm/pkg/estimator/pb"
+)
+
+// SnapshotFunc 返回 cluster 中的所有节点以及未结束的 Pod。
+// 返回的错误应为 gRPC status 错误，其他错误会以 codes.Unavailable 返回给调用方。
+type SnapshotFunc func(ctx context.Context, cluster string) ([]*corev1.Node, []*corev1.Pod, error)
+
+// Server 实现 Estimator 服务，节点与 Pod 由 SnapshotFunc 提供，
+// 因此同一个实现既可以独立运行在成员集群中，也可以嵌入 kubellm-controller 为多个集群提供服务
+type Server struct {
+	pb.UnimplementedEstimatorServer
+
+	snapshot SnapshotFunc
+}
+
+// NewServer 创建 Estimator 服务
+func NewServer(snapshot SnapshotFunc) *Server {
+	return &Server{snapshot: snapshot}
+}
+
+// MaxAvailableReplicas 实现 pb.EstimatorServer
+func (s *Server) MaxAvailableReplicas(ctx context.Context, req *pb.MaxAvailableReplicasRequest) (*pb.MaxAvailableReplicasResponse, error) {
+	if len(req.Cluster) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "cluster must be set")
+	}
+	template := &corev1.PodTemplateSpec{}
+	if err := json.Unmarshal(req.PodTemplate, template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode pod template: %v", err)
+	}
+
+	nodes, pods, err := s.snapshot(ctx, req.Cluster)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to list nodes and pods of cluster %s: %v", req.Cluster, err)
+	}
+
+	replicas := maxAvailableReplicas(nodes, pods, &corev1.Pod{ObjectMeta: template.ObjectMeta, Spec: template.Spec})
+	klog.FromContext(ctx).V(4).Info("Estimated max available replicas", "cluster", req.Cluster, "replicas", replicas, "nodes", len(nodes))
+	return &pb.MaxAvailableReplicasResponse{MaxReplicas: replicas}, nil
+}
+
+// Serve 在 lis 上提供 Estimator 服务与 gRPC 健康检查服务，ctx 结束时停止接收新请求并等待处理中的请求完成
+func Serve(ctx context.Context, lis net.Listener, server *Server, opts ...grpc.ServerOption) error {
+	grpcServer := grpc.NewServer(opts...)
+	pb.RegisterEstimatorServer(grpcServer, server)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	go func() {
+		<-ctx.Done()
+		healthServer.Shutdown()
+		grpcServer.GracefulStop()
+	}()
+	klog.FromContext(ctx).Info("Serving estimator", "address", lis.Addr().String())
+	return grpcServer.Serve(lis)
+}
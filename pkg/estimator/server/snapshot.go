@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	clusterlisters "github.com/kubellm-io/kubellm/pkg/generated/listers/cluster.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+)
+
+// NewListerSnapshot 返回在成员集群中独立运行时使用的 SnapshotFunc，从本集群的 Informer 缓存中读取节点与 Pod。
+// 请求的集群不是 clusterName 时返回 codes.NotFound，避免调用方把其他集群的容量算到本集群上。
+func NewListerSnapshot(clusterName string, nodeLister corelisters.NodeLister, podLister corelisters.PodLister) SnapshotFunc {
+	return func(_ context.Context, cluster string) ([]*corev1.Node, []*corev1.Pod, error) {
+		if cluster != clusterName {
+			return nil, nil, status.Errorf(codes.NotFound, "the estimator serves cluster %s, not %s", clusterName, cluster)
+		}
+		nodes, err := nodeLister.List(labels.Everything())
+		if err != nil {
+			return nil, nil, err
+		}
+		allPods, err := podLister.List(labels.Everything())
+		if err != nil {
+			return nil, nil, err
+		}
+		pods := make([]*corev1.Pod, 0, len(allPods))
+		for _, pod := range allPods {
+			if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+				pods = append(pods, pod)
+			}
+		}
+		return nodes, pods, nil
+	}
+}
+
+// NewMemberClusterSnapshot 返回嵌入 kubellm-controller 时使用的 SnapshotFunc，每次请求都通过成员集群客户端列出节点与 Pod。
+// Pull 模式的集群无法从控制面直接访问，需要在成员集群中独立运行 estimator。
+func NewMemberClusterSnapshot(clusterLister clusterlisters.ClusterLister, clientCache *membercluster.ClientCache) SnapshotFunc {
+	return func(ctx context.Context, name string) ([]*corev1.Node, []*corev1.Pod, error) {
+		cluster, err := clusterLister.Get(name)
+		if apierrors.IsNotFound(err) {
+			return nil, nil, status.Errorf(codes.NotFound, "cluster %s is not found", name)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if cluster.Spec.SyncMode == clusterv1alpha1.Pull {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "cluster %s is in %s mode, its capacity is estimated by the estimator running in the member cluster",
+				name, clusterv1alpha1.Pull)
+		}
+
+		clients, err := clientCache.Get(cluster)
+		if err != nil {
+			return nil, nil, err
+		}
+		nodes, err := membercluster.ListNodes(ctx, clients.Kube)
+		if err != nil {
+			return nil, nil, err
+		}
+		pods, err := membercluster.ListPods(ctx, clients.Kube)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nodes, pods, nil
+	}
+}
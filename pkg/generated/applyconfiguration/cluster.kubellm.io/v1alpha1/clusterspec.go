@@ -18,24 +18,26 @@ limitations under the License.
 package v1alpha1
 
 import (
+	clusterkubellmiov1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
 	v1 "k8s.io/api/core/v1"
 )
 
 // ClusterSpecApplyConfiguration represents a declarative configuration of the ClusterSpec type for use
 // with apply.
 type ClusterSpecApplyConfiguration struct {
-	ID                          *string                                 `json:"id,omitempty"`
-	DisplayName                 *string                                 `json:"displayName,omitempty"`
-	APIEndpoint                 *string                                 `json:"apiEndpoint,omitempty"`
-	SecretRef                   *LocalSecretReferenceApplyConfiguration `json:"secretRef,omitempty"`
-	ImpersonatorSecretRef       *LocalSecretReferenceApplyConfiguration `json:"impersonatorSecretRef,omitempty"`
-	InsecureSkipTLSVerification *bool                                   `json:"insecureSkipTLSVerification,omitempty"`
-	ProxyURL                    *string                                 `json:"proxyURL,omitempty"`
-	ProxyHeader                 map[string]string                       `json:"proxyHeader,omitempty"`
-	Provider                    *string                                 `json:"provider,omitempty"`
-	Region                      *string                                 `json:"region,omitempty"`
-	Taints                      []v1.Taint                              `json:"taints,omitempty"`
-	ResourceModels              []ResourceModelApplyConfiguration       `json:"resourceModels,omitempty"`
+	ID                          *string                                   `json:"id,omitempty"`
+	DisplayName                 *string                                   `json:"displayName,omitempty"`
+	SyncMode                    *clusterkubellmiov1alpha1.ClusterSyncMode `json:"syncMode,omitempty"`
+	APIEndpoint                 *string                                   `json:"apiEndpoint,omitempty"`
+	SecretRef                   *LocalSecretReferenceApplyConfiguration   `json:"secretRef,omitempty"`
+	ImpersonatorSecretRef       *LocalSecretReferenceApplyConfiguration   `json:"impersonatorSecretRef,omitempty"`
+	InsecureSkipTLSVerification *bool                                     `json:"insecureSkipTLSVerification,omitempty"`
+	ProxyURL                    *string                                   `json:"proxyURL,omitempty"`
+	ProxyHeader                 map[string]string                         `json:"proxyHeader,omitempty"`
+	Provider                    *string                                   `json:"provider,omitempty"`
+	Region                      *string                                   `json:"region,omitempty"`
+	Taints                      []v1.Taint                                `json:"taints,omitempty"`
+	ResourceModels              []ResourceModelApplyConfiguration         `json:"resourceModels,omitempty"`
 }
 
 // ClusterSpecApplyConfiguration constructs a declarative configuration of the ClusterSpec type for use with
@@ -60,6 +62,14 @@ func (b *ClusterSpecApplyConfiguration) WithDisplayName(value string) *ClusterSp
 	return b
 }
 
+// WithSyncMode sets the SyncMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyncMode field is set to the value of the last call.
+func (b *ClusterSpecApplyConfiguration) WithSyncMode(value clusterkubellmiov1alpha1.ClusterSyncMode) *ClusterSpecApplyConfiguration {
+	b.SyncMode = &value
+	return b
+}
+
 // WithAPIEndpoint sets the APIEndpoint field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the APIEndpoint field is set to the value of the last call.
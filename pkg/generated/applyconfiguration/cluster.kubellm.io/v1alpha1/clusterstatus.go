@@ -18,6 +18,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -27,8 +28,10 @@ type ClusterStatusApplyConfiguration struct {
 	KubernetesVersion *string                            `json:"kubernetesVersion,omitempty"`
 	APIEnablements    []APIEnablementApplyConfiguration  `json:"apiEnablements,omitempty"`
 	Conditions        []v1.ConditionApplyConfiguration   `json:"conditions,omitempty"`
+	LastHeartbeatTime *metav1.Time                       `json:"lastHeartbeatTime,omitempty"`
 	NodeSummary       *NodeSummaryApplyConfiguration     `json:"nodeSummary,omitempty"`
 	ResourceSummary   *ResourceSummaryApplyConfiguration `json:"resourceSummary,omitempty"`
+	GPUInventory      []GPUSummaryApplyConfiguration     `json:"gpuInventory,omitempty"`
 	RemedyActions     []string                           `json:"remedyActions,omitempty"`
 }
 
@@ -72,6 +75,14 @@ func (b *ClusterStatusApplyConfiguration) WithConditions(values ...*v1.Condition
 	return b
 }
 
+// WithLastHeartbeatTime sets the LastHeartbeatTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastHeartbeatTime field is set to the value of the last call.
+func (b *ClusterStatusApplyConfiguration) WithLastHeartbeatTime(value metav1.Time) *ClusterStatusApplyConfiguration {
+	b.LastHeartbeatTime = &value
+	return b
+}
+
 // WithNodeSummary sets the NodeSummary field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NodeSummary field is set to the value of the last call.
@@ -88,6 +99,19 @@ func (b *ClusterStatusApplyConfiguration) WithResourceSummary(value *ResourceSum
 	return b
 }
 
+// WithGPUInventory adds the given value to the GPUInventory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the GPUInventory field.
+func (b *ClusterStatusApplyConfiguration) WithGPUInventory(values ...*GPUSummaryApplyConfiguration) *ClusterStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithGPUInventory")
+		}
+		b.GPUInventory = append(b.GPUInventory, *values[i])
+	}
+	return b
+}
+
 // WithRemedyActions adds the given value to the RemedyActions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the RemedyActions field.
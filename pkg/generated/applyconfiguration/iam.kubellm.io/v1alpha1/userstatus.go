@@ -26,16 +26,20 @@ import (
 // UserStatusApplyConfiguration represents a declarative configuration of the UserStatus type for use
 // with apply.
 type UserStatusApplyConfiguration struct {
-	State                   *iamkubellmiov1alpha1.UserState      `json:"state,omitempty"`
-	Reason                  *string                              `json:"reason,omitempty"`
-	Message                 *string                              `json:"message,omitempty"`
-	LastTransitionTime      *v1.Time                             `json:"lastTransitionTime,omitempty"`
-	LastLoginTime           *v1.Time                             `json:"lastLoginTime,omitempty"`
-	LastLoginIP             *string                              `json:"lastLoginIp,omitempty"`
-	FailedLoginAttempts     *int32                               `json:"failedLoginAttempts,omitempty"`
-	PasswordExpiryTime      *v1.Time                             `json:"passwordExpiryTime,omitempty"`
-	PasswordLastChangedTime *v1.Time                             `json:"passwordLastChangedTime,omitempty"`
-	Conditions              []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	State                   *iamkubellmiov1alpha1.UserState         `json:"state,omitempty"`
+	Reason                  *string                                 `json:"reason,omitempty"`
+	Message                 *string                                 `json:"message,omitempty"`
+	LastTransitionTime      *v1.Time                                `json:"lastTransitionTime,omitempty"`
+	LastLoginTime           *v1.Time                                `json:"lastLoginTime,omitempty"`
+	LastLoginIP             *string                                 `json:"lastLoginIp,omitempty"`
+	FailedLoginAttempts     *int32                                  `json:"failedLoginAttempts,omitempty"`
+	FirstFailedLoginTime    *v1.Time                                `json:"firstFailedLoginTime,omitempty"`
+	LastFailedLoginTime     *v1.Time                                `json:"lastFailedLoginTime,omitempty"`
+	PasswordExpiryTime      *v1.Time                                `json:"passwordExpiryTime,omitempty"`
+	PasswordLastChangedTime *v1.Time                                `json:"passwordLastChangedTime,omitempty"`
+	PasswordHistory         []string                                `json:"passwordHistory,omitempty"`
+	RefreshTokenSessions    []RefreshTokenSessionApplyConfiguration `json:"refreshTokenSessions,omitempty"`
+	Conditions              []metav1.ConditionApplyConfiguration    `json:"conditions,omitempty"`
 }
 
 // UserStatusApplyConfiguration constructs a declarative configuration of the UserStatus type for use with
@@ -100,6 +104,22 @@ func (b *UserStatusApplyConfiguration) WithFailedLoginAttempts(value int32) *Use
 	return b
 }
 
+// WithFirstFailedLoginTime sets the FirstFailedLoginTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FirstFailedLoginTime field is set to the value of the last call.
+func (b *UserStatusApplyConfiguration) WithFirstFailedLoginTime(value v1.Time) *UserStatusApplyConfiguration {
+	b.FirstFailedLoginTime = &value
+	return b
+}
+
+// WithLastFailedLoginTime sets the LastFailedLoginTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailedLoginTime field is set to the value of the last call.
+func (b *UserStatusApplyConfiguration) WithLastFailedLoginTime(value v1.Time) *UserStatusApplyConfiguration {
+	b.LastFailedLoginTime = &value
+	return b
+}
+
 // WithPasswordExpiryTime sets the PasswordExpiryTime field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PasswordExpiryTime field is set to the value of the last call.
@@ -116,6 +136,29 @@ func (b *UserStatusApplyConfiguration) WithPasswordLastChangedTime(value v1.Time
 	return b
 }
 
+// WithPasswordHistory adds the given value to the PasswordHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PasswordHistory field.
+func (b *UserStatusApplyConfiguration) WithPasswordHistory(values ...string) *UserStatusApplyConfiguration {
+	for i := range values {
+		b.PasswordHistory = append(b.PasswordHistory, values[i])
+	}
+	return b
+}
+
+// WithRefreshTokenSessions adds the given value to the RefreshTokenSessions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RefreshTokenSessions field.
+func (b *UserStatusApplyConfiguration) WithRefreshTokenSessions(values ...*RefreshTokenSessionApplyConfiguration) *UserStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRefreshTokenSessions")
+		}
+		b.RefreshTokenSessions = append(b.RefreshTokenSessions, *values[i])
+	}
+	return b
+}
+
 // WithConditions adds the given value to the Conditions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Conditions field.
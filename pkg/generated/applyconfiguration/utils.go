@@ -41,14 +41,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &clusterkubellmiov1alpha1.APIResourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Cluster"):
 		return &clusterkubellmiov1alpha1.ClusterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClusterAffinity"):
+		return &clusterkubellmiov1alpha1.ClusterAffinityApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClusterConditionRequirement"):
+		return &clusterkubellmiov1alpha1.ClusterConditionRequirementApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterSpec"):
 		return &clusterkubellmiov1alpha1.ClusterSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterStatus"):
 		return &clusterkubellmiov1alpha1.ClusterStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DecisionMatch"):
+		return &clusterkubellmiov1alpha1.DecisionMatchApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GPUSummary"):
+		return &clusterkubellmiov1alpha1.GPUSummaryApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("LocalSecretReference"):
 		return &clusterkubellmiov1alpha1.LocalSecretReferenceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NodeSummary"):
 		return &clusterkubellmiov1alpha1.NodeSummaryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Remedy"):
+		return &clusterkubellmiov1alpha1.RemedyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RemedySpec"):
+		return &clusterkubellmiov1alpha1.RemedySpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResourceModel"):
 		return &clusterkubellmiov1alpha1.ResourceModelApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResourceModelRange"):
@@ -57,6 +69,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &clusterkubellmiov1alpha1.ResourceSummaryApplyConfiguration{}
 
 		// Group=iam.kubellm.io, Version=v1alpha1
+	case iamkubellmiov1alpha1.SchemeGroupVersion.WithKind("RefreshTokenSession"):
+		return &applyconfigurationiamkubellmiov1alpha1.RefreshTokenSessionApplyConfiguration{}
 	case iamkubellmiov1alpha1.SchemeGroupVersion.WithKind("User"):
 		return &applyconfigurationiamkubellmiov1alpha1.UserApplyConfiguration{}
 	case iamkubellmiov1alpha1.SchemeGroupVersion.WithKind("UserSpec"):
@@ -28,6 +28,7 @@ import (
 type ClusterV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	ClustersGetter
+	RemediesGetter
 }
 
 // ClusterV1alpha1Client is used to interact with features provided by the cluster.kubellm.io group.
@@ -39,6 +40,10 @@ func (c *ClusterV1alpha1Client) Clusters() ClusterInterface {
 	return newClusters(c)
 }
 
+func (c *ClusterV1alpha1Client) Remedies() RemedyInterface {
+	return newRemedies(c)
+}
+
 // NewForConfig creates a new ClusterV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -25,6 +25,8 @@ import (
 type Interface interface {
 	// Clusters returns a ClusterInformer.
 	Clusters() ClusterInformer
+	// Remedies returns a RemedyInformer.
+	Remedies() RemedyInformer
 }
 
 type version struct {
@@ -42,3 +44,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) Clusters() ClusterInformer {
 	return &clusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// Remedies returns a RemedyInformer.
+func (v *version) Remedies() RemedyInformer {
+	return &remedyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,58 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/registration"
+)
+
+// bootstrapper 返回属于 kubellm bootstrap token 组的请求者，其余请求者返回 nil
+func bootstrapper(ctx context.Context) user.Info {
+	requester, ok := genericapirequest.UserFrom(ctx)
+	if !ok || !slices.Contains(requester.GetGroups(), registration.BootstrapGroup) {
+		return nil
+	}
+	return requester
+}
+
+// validateBootstrapCluster 限制 bootstrap token 创建的 Cluster：只能是 Pull 模式，
+// 并且不能携带控制面访问成员集群的地址与凭证，否则持有 token 的人可以让控制面以任意凭证连接任意地址。
+func validateBootstrapCluster(cluster *clusterkubellmio.Cluster) field.ErrorList {
+	var allErrs field.ErrorList
+	fldPath := field.NewPath("spec")
+	if cluster.Spec.SyncMode != clusterkubellmio.Pull {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("syncMode"), cluster.Spec.SyncMode, []string{string(clusterkubellmio.Pull)}))
+	}
+	if len(cluster.Spec.APIEndpoint) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("apiEndpoint"), "may not be set by a bootstrap token"))
+	}
+	if len(cluster.Spec.ProxyURL) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("proxyURL"), "may not be set by a bootstrap token"))
+	}
+	if cluster.Spec.SecretRef != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretRef"), "may not be set by a bootstrap token"))
+	}
+	if cluster.Spec.ImpersonatorSecretRef != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("impersonatorSecretRef"), "may not be set by a bootstrap token"))
+	}
+	return allErrs
+}
+
+// authorizeBootstrapperGet 只允许 bootstrap token 读取它自己创建的 Cluster。
+// RBAC 无法按创建者授权，因此在存储层比较 BootstrapUserAnnotation。
+func authorizeBootstrapperGet(ctx context.Context, cluster *clusterkubellmio.Cluster) error {
+	requester := bootstrapper(ctx)
+	if requester == nil || cluster.Annotations[registration.BootstrapUserAnnotation] == requester.GetName() {
+		return nil
+	}
+	return apierrors.NewForbidden(clusterkubellmio.Resource(clusterkubellmio.ResourcePluralCluster), cluster.Name,
+		fmt.Errorf("cluster was not created by %s", requester.GetName()))
+}
@@ -0,0 +1,198 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/proxy"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	restclient "k8s.io/client-go/rest"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+	"github.com/kubellm-io/kubellm/pkg/util/tunnel"
+)
+
+// proxyMethods 是 proxy 子资源支持的 HTTP 方法
+var proxyMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
+// UserGetterFunc 根据名称获取 kubellm User
+type UserGetterFunc func(ctx context.Context, name string) (*iamkubellmio.User, error)
+
+// ProxyREST 实现了 Cluster 的 proxy 子资源，把
+// /apis/cluster.kubellm.io/v1alpha1/clusters/{name}/proxy/{path} 的请求转发到成员集群的 {path}
+type ProxyREST struct {
+	store        *genericregistry.Store
+	secretGetter membercluster.SecretGetterFunc
+	clientCache  *membercluster.ClientCache
+	userGetter   UserGetterFunc
+	tunnels      *tunnel.Server
+}
+
+var _ rest.Connecter = &ProxyREST{}
+
+// New 返回 proxy 子资源的选项对象
+func (r *ProxyREST) New() runtime.Object {
+	return &clusterkubellmio.ClusterProxyOptions{}
+}
+
+// Destroy 在关闭时清理资源，底层存储由主资源负责释放
+func (r *ProxyREST) Destroy() {
+}
+
+// ConnectMethods 返回 proxy 子资源支持的 HTTP 方法
+func (r *ProxyREST) ConnectMethods() []string {
+	return proxyMethods
+}
+
+// NewConnectOptions 返回 proxy 子资源的选项对象，子路径会填充到 Path 字段
+func (r *ProxyREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &clusterkubellmio.ClusterProxyOptions{}, true, "path"
+}
+
+// Connect 根据 Cluster 的连接信息构造到成员集群的反向代理，Pull 模式的集群经由 agent 建立的隧道访问。
+// 集群设置了 ImpersonatorSecretRef 时，使用 impersonator 的 token 并以请求者对应的 kubellm User 的名称与 Spec.Groups
+// 模拟身份，由成员集群的 RBAC 按人授权；请求者不是 kubellm User 时拒绝请求，不会退回控制面的凭证。
+func (r *ProxyREST) Connect(ctx context.Context, id string, options runtime.Object, responder rest.Responder) (http.Handler, error) {
+	proxyOpts, ok := options.(*clusterkubellmio.ClusterProxyOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options object: %#v", options)
+	}
+
+	obj, err := r.store.Get(ctx, id, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.(*clusterkubellmio.Cluster).Spec.SyncMode == clusterkubellmio.Pull {
+		return r.connectTunnel(ctx, id, proxyOpts.Path, responder)
+	}
+
+	cluster := &clusterv1alpha1.Cluster{}
+	if err := clusterv1alpha1.Convert_clusterkubellmio_Cluster_To_v1alpha1_Cluster(obj.(*clusterkubellmio.Cluster), cluster, nil); err != nil {
+		return nil, err
+	}
+
+	clients, err := r.clientCache.Get(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	token := clients.Config.BearerToken
+	var impersonate *restclient.ImpersonationConfig
+	if cluster.Spec.ImpersonatorSecretRef != nil {
+		if impersonate, err = r.impersonationConfig(ctx, id); err != nil {
+			return nil, err
+		}
+		if token, err = membercluster.ImpersonatorToken(cluster, r.secretGetter); err != nil {
+			return nil, err
+		}
+	}
+
+	location, err := membercluster.EndpointURL(clients.Config.Host)
+	if err != nil {
+		return nil, err
+	}
+	location.Path = joinProxyPath(location.Path, proxyOpts.Path)
+
+	return newProxyHandler(location, clients.Transport, token, impersonate, responder), nil
+}
+
+// impersonationConfig 返回请求者对应的 kubellm User 在成员集群中的模拟身份。
+// impersonator 只能模拟 kubellm 中的用户，请求者不是 kubellm User 时返回 Forbidden。
+func (r *ProxyREST) impersonationConfig(ctx context.Context, clusterName string) (*restclient.ImpersonationConfig, error) {
+	forbidden := apierrors.NewForbidden(clusterkubellmio.Resource("clusters/proxy"), clusterName,
+		fmt.Errorf("only kubellm users can access clusters through the impersonator"))
+	requester, ok := genericapirequest.UserFrom(ctx)
+	if !ok || r.userGetter == nil {
+		return nil, forbidden
+	}
+	user, err := r.userGetter(ctx, requester.GetName())
+	if apierrors.IsNotFound(err) {
+		return nil, forbidden
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &restclient.ImpersonationConfig{
+		UserName: user.Name,
+		Groups:   user.Spec.Groups,
+	}, nil
+}
+
+// connectTunnel 构造经由反向隧道转发的 Handler。
+// agent 只以 impersonator 的身份转发请求，因此请求者必须是 kubellm User，并以其名称与 Spec.Groups 模拟身份。
+// 隧道基于 HTTP/2，因此不支持 exec 等 upgrade 请求。
+func (r *ProxyREST) connectTunnel(ctx context.Context, clusterName, proxyPath string, responder rest.Responder) (http.Handler, error) {
+	impersonate, err := r.impersonationConfig(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// 不活跃的副本经由活跃副本的 relay 转发，隧道是否存在由活跃副本判断
+	location, transport, ok := r.tunnels.Route(clusterName)
+	if !ok {
+		return nil, apierrors.NewServiceUnavailable(fmt.Sprintf("no tunnel is established by the agent of cluster %s", clusterName))
+	}
+	location.Path = joinProxyPath(location.Path, proxyPath)
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if httpstream.IsUpgradeRequest(req) {
+			responder.Error(apierrors.NewBadRequest(fmt.Sprintf("upgrade requests are not supported for clusters in %s mode", clusterkubellmio.Pull)))
+			return
+		}
+		newProxyHandler(location, transport, "", impersonate, responder).ServeHTTP(rw, req)
+	}), nil
+}
+
+// joinProxyPath 拼接转发路径，path.Join 会去掉末尾的 '/'，这里保持与原始请求一致
+func joinProxyPath(base, proxyPath string) string {
+	joined := path.Join(base, proxyPath)
+	if strings.HasSuffix(proxyPath, "/") && !strings.HasSuffix(joined, "/") {
+		joined += "/"
+	}
+	return joined
+}
+
+// newProxyHandler 返回转发请求的 Handler。
+// 客户端自带的认证与模拟头会被移除，统一替换为控制面访问成员集群的凭证与模拟身份；
+// 由于凭证写在请求头上，watch 与 exec、port-forward 等 upgrade 请求同样生效。
+func newProxyHandler(location *url.URL, transport http.RoundTripper, token string, impersonate *restclient.ImpersonationConfig, responder rest.Responder) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		removeAuthHeaders(req.Header)
+		if len(token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if impersonate != nil {
+			req.Header.Set("Impersonate-User", impersonate.UserName)
+			for _, group := range impersonate.Groups {
+				req.Header.Add("Impersonate-Group", group)
+			}
+		}
+
+		handler := proxy.NewUpgradeAwareHandler(location, transport, false, false, proxy.NewErrorResponder(responder))
+		handler.UseLocationHost = true
+		handler.ServeHTTP(rw, req)
+	})
+}
+
+// removeAuthHeaders 删除请求中与身份相关的头，防止客户端借控制面的身份访问成员集群
+func removeAuthHeaders(header http.Header) {
+	for key := range header {
+		if strings.EqualFold(key, "Authorization") || strings.HasPrefix(strings.ToLower(key), "impersonate-") {
+			header.Del(key)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+func TestImpersonationConfig(t *testing.T) {
+	users := UserGetterFunc(func(_ context.Context, name string) (*iamkubellmio.User, error) {
+		if name != "alice" {
+			return nil, apierrors.NewNotFound(iamkubellmio.Resource("users"), name)
+		}
+		return &iamkubellmio.User{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       iamkubellmio.UserSpec{Groups: []string{"developers"}},
+		}, nil
+	})
+
+	tests := []struct {
+		name       string
+		userGetter UserGetterFunc
+		requester  user.Info
+		// expectedUser 为空表示期望请求被拒绝
+		expectedUser string
+	}{
+		{
+			name:         "kubellm user",
+			userGetter:   users,
+			requester:    &user.DefaultInfo{Name: "alice"},
+			expectedUser: "alice",
+		},
+		{
+			// 不是 kubellm User 的请求者不能退回控制面的凭证
+			name:       "not a kubellm user",
+			userGetter: users,
+			requester:  &user.DefaultInfo{Name: "system:admin", Groups: []string{"system:masters"}},
+		},
+		{
+			name:       "no requester",
+			userGetter: users,
+		},
+		{
+			name:      "no user getter",
+			requester: &user.DefaultInfo{Name: "alice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.requester != nil {
+				ctx = genericapirequest.WithUser(ctx, tt.requester)
+			}
+			r := &ProxyREST{userGetter: tt.userGetter}
+			impersonate, err := r.impersonationConfig(ctx, "member")
+			if len(tt.expectedUser) == 0 {
+				if !apierrors.IsForbidden(err) {
+					t.Errorf("expected Forbidden, got %v, %+v", err, impersonate)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if impersonate.UserName != tt.expectedUser || len(impersonate.Groups) != 1 || impersonate.Groups[0] != "developers" {
+				t.Errorf("unexpected impersonation config %+v", impersonate)
+			}
+		})
+	}
+}
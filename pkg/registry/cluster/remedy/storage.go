@@ -0,0 +1,40 @@
+package remedy
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+)
+
+// REST 实现了 Remedy 资源的 RESTStorage，处理标准的增删改查与 Watch 请求
+type REST struct {
+	*genericregistry.Store
+}
+
+// NewREST 创建基于 etcd 的 Remedy 存储
+func NewREST(scheme *runtime.Scheme, optsGetter generic.RESTOptionsGetter) (*REST, error) {
+	strategy := NewStrategy(scheme)
+
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &clusterkubellmio.Remedy{} },
+		NewListFunc:               func() runtime.Object { return &clusterkubellmio.RemedyList{} },
+		PredicateFunc:             MatchRemedy,
+		DefaultQualifiedResource:  clusterkubellmio.Resource(clusterkubellmio.ResourcePluralRemedy),
+		SingularQualifiedResource: clusterkubellmio.Resource(clusterkubellmio.ResourceSingularRemedy),
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		TableConvertor: rest.NewDefaultTableConvertor(clusterkubellmio.Resource(clusterkubellmio.ResourcePluralRemedy)),
+	}
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return nil, err
+	}
+
+	return &REST{Store: store}, nil
+}
@@ -0,0 +1,105 @@
+package remedy
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+)
+
+// remedyStrategy 实现了 Remedy 资源在创建、更新、删除时的业务逻辑
+type remedyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy 创建 Remedy 资源的存储策略
+func NewStrategy(typer runtime.ObjectTyper) remedyStrategy {
+	return remedyStrategy{typer, names.SimpleNameGenerator}
+}
+
+// GetAttrs 返回 Remedy 对象的标签与可用于字段选择器的字段集合
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	remedy, ok := obj.(*clusterkubellmio.Remedy)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a Remedy")
+	}
+	return labels.Set(remedy.Labels), SelectableFields(remedy), nil
+}
+
+// MatchRemedy 返回 etcd 存储在 List/Watch 时用于按标签和字段过滤 Remedy 的谓词
+func MatchRemedy(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// SelectableFields 返回 Remedy 可用于字段选择器的字段集合
+func SelectableFields(remedy *clusterkubellmio.Remedy) fields.Set {
+	return generic.ObjectMetaFieldsSet(&remedy.ObjectMeta, false)
+}
+
+// NamespaceScoped Remedy 为集群级资源
+func (remedyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate 创建前初始化 Generation
+func (remedyStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	remedy := obj.(*clusterkubellmio.Remedy)
+	remedy.Generation = 1
+}
+
+// PrepareForUpdate Spec 变化时递增 Generation
+func (remedyStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newRemedy := obj.(*clusterkubellmio.Remedy)
+	oldRemedy := old.(*clusterkubellmio.Remedy)
+	if !apiequality.Semantic.DeepEqual(newRemedy.Spec, oldRemedy.Spec) {
+		newRemedy.Generation = oldRemedy.Generation + 1
+	}
+}
+
+// Validate 校验新创建的 Remedy
+func (remedyStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateRemedy(obj.(*clusterkubellmio.Remedy))
+}
+
+// WarningsOnCreate 返回创建 Remedy 时的告警信息
+func (remedyStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// AllowCreateOnUpdate 不允许通过 Update 创建 Remedy
+func (remedyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate 允许不带 resourceVersion 的更新
+func (remedyStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// Canonicalize 规范化对象，Remedy 无需处理
+func (remedyStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate 校验 Remedy 的更新
+func (remedyStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateRemedyUpdate(obj.(*clusterkubellmio.Remedy), old.(*clusterkubellmio.Remedy))
+}
+
+// WarningsOnUpdate 返回更新 Remedy 时的告警信息
+func (remedyStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,118 @@
+package remedy
+
+import (
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+)
+
+var supportedOperators = sets.New(
+	string(clusterkubellmio.ClusterConditionEqual),
+	string(clusterkubellmio.ClusterConditionNotEqual),
+)
+
+var supportedConditionStatuses = sets.New(
+	string(metav1.ConditionTrue),
+	string(metav1.ConditionFalse),
+	string(metav1.ConditionUnknown),
+)
+
+var supportedActions = sets.New(
+	string(clusterkubellmio.TrafficControl),
+	string(clusterkubellmio.StopScheduling),
+)
+
+// ValidateRemedy 校验 Remedy 对象
+func ValidateRemedy(remedy *clusterkubellmio.Remedy) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&remedy.ObjectMeta, false, path.ValidatePathSegmentName, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateRemedySpec(&remedy.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateRemedyUpdate 校验 Remedy 的更新
+func ValidateRemedyUpdate(newRemedy, oldRemedy *clusterkubellmio.Remedy) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&newRemedy.ObjectMeta, &oldRemedy.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateRemedySpec(&newRemedy.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateRemedySpec 校验 RemedySpec 中的集群选择、匹配条件与动作
+func ValidateRemedySpec(spec *clusterkubellmio.RemedySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.ClusterAffinity != nil {
+		namesPath := fldPath.Child("clusterAffinity", "clusterNames")
+		seen := sets.New[string]()
+		for i, name := range spec.ClusterAffinity.ClusterNames {
+			if len(name) == 0 {
+				allErrs = append(allErrs, field.Required(namesPath.Index(i), ""))
+				continue
+			}
+			if seen.Has(name) {
+				allErrs = append(allErrs, field.Duplicate(namesPath.Index(i), name))
+			}
+			seen.Insert(name)
+		}
+	}
+
+	for i := range spec.DecisionMatches {
+		matchPath := fldPath.Child("decisionMatches").Index(i)
+		// 目前只支持按集群条件匹配，因此 clusterConditionMatch 为必填
+		if spec.DecisionMatches[i].ClusterConditionMatch == nil {
+			allErrs = append(allErrs, field.Required(matchPath.Child("clusterConditionMatch"), ""))
+			continue
+		}
+		allErrs = append(allErrs, validateClusterConditionRequirement(spec.DecisionMatches[i].ClusterConditionMatch, matchPath.Child("clusterConditionMatch"))...)
+	}
+
+	seen := sets.New[string]()
+	for i, action := range spec.Actions {
+		actionPath := fldPath.Child("actions").Index(i)
+		if !supportedActions.Has(string(action)) {
+			allErrs = append(allErrs, field.NotSupported(actionPath, action, sets.List(supportedActions)))
+			continue
+		}
+		if seen.Has(string(action)) {
+			allErrs = append(allErrs, field.Duplicate(actionPath, action))
+		}
+		seen.Insert(string(action))
+	}
+
+	return allErrs
+}
+
+func validateClusterConditionRequirement(requirement *clusterkubellmio.ClusterConditionRequirement, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// 与 metav1.Condition 对 type 的约束保持一致
+	if len(requirement.ConditionType) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("conditionType"), ""))
+	} else {
+		for _, msg := range validation.IsQualifiedName(requirement.ConditionType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("conditionType"), requirement.ConditionType, msg))
+		}
+	}
+
+	if len(requirement.Operator) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("operator"), ""))
+	} else if !supportedOperators.Has(string(requirement.Operator)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operator"), requirement.Operator, sets.List(supportedOperators)))
+	}
+
+	if len(requirement.ConditionStatus) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("conditionStatus"), ""))
+	} else if !supportedConditionStatuses.Has(string(requirement.ConditionStatus)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("conditionStatus"), requirement.ConditionStatus, sets.List(supportedConditionStatuses)))
+	}
+
+	if requirement.Duration != nil && requirement.Duration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), requirement.Duration.Duration.String(), "must be greater than or equal to 0"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+)
+
+// REST 实现了 Cluster 资源的 RESTStorage，处理标准的增删改查与 Watch 请求
+type REST struct {
+	*genericregistry.Store
+}
+
+// Get 获取 Cluster 对象，bootstrap token 只能读取它自己创建的 Cluster
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := r.Store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeBootstrapperGet(ctx, obj.(*clusterkubellmio.Cluster)); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// StatusREST 实现了 Cluster 的 status 子资源
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var _ rest.Patcher = &StatusREST{}
+
+// New 创建一个新的 Cluster 对象
+func (r *StatusREST) New() runtime.Object {
+	return &clusterkubellmio.Cluster{}
+}
+
+// Destroy 在关闭时清理资源，底层存储由主资源负责释放
+func (r *StatusREST) Destroy() {
+}
+
+// Get 获取 Cluster 对象，供 patch 使用
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update 只修改 Cluster 的 Status
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc,
+	updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// 子资源不允许通过更新创建对象
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// GetResetFields 实现 rest.ResetFieldsStrategy
+func (r *StatusREST) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return r.store.GetResetFields()
+}
@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/util/membercluster"
+	"github.com/kubellm-io/kubellm/pkg/util/tunnel"
+)
+
+// ClusterStorage 聚合了 Cluster 主资源及其子资源的存储
+type ClusterStorage struct {
+	Cluster *REST
+	Status  *StatusREST
+	Proxy   *ProxyREST
+	Tunnel  *TunnelREST
+}
+
+// NewStorage 创建基于 etcd 的 Cluster 存储，secretGetter 用于读取 impersonator 的凭证，
+// clientCache 缓存访问成员集群的连接，userGetter 用于查找需要在成员集群中模拟的 kubellm User，tunnels 维护 Pull 模式成员集群建立的反向隧道
+func NewStorage(scheme *runtime.Scheme, optsGetter generic.RESTOptionsGetter, secretGetter membercluster.SecretGetterFunc,
+	clientCache *membercluster.ClientCache, userGetter UserGetterFunc, tunnels *tunnel.Server) (*ClusterStorage, error) {
+	strategy := NewStrategy(scheme)
+
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &clusterkubellmio.Cluster{} },
+		NewListFunc:               func() runtime.Object { return &clusterkubellmio.ClusterList{} },
+		PredicateFunc:             MatchCluster,
+		DefaultQualifiedResource:  clusterkubellmio.Resource(clusterkubellmio.ResourcePluralCluster),
+		SingularQualifiedResource: clusterkubellmio.Resource(clusterkubellmio.ResourceSingularCluster),
+
+		CreateStrategy:      strategy,
+		UpdateStrategy:      strategy,
+		DeleteStrategy:      strategy,
+		ResetFieldsStrategy: strategy,
+
+		TableConvertor: NewTableConvertor(),
+
+		// Cluster 被删除后释放缓存的连接
+		AfterDelete: func(obj runtime.Object, _ *metav1.DeleteOptions) {
+			clientCache.Remove(obj.(*clusterkubellmio.Cluster).Name)
+		},
+	}
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs, Indexers: Indexers()}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return nil, err
+	}
+
+	statusStrategy := NewStatusStrategy(strategy)
+	statusStore := *store
+	statusStore.UpdateStrategy = statusStrategy
+	statusStore.ResetFieldsStrategy = statusStrategy
+
+	return &ClusterStorage{
+		Cluster: &REST{Store: store},
+		Status:  &StatusREST{store: &statusStore},
+		Proxy:   &ProxyREST{store: store, secretGetter: secretGetter, clientCache: clientCache, userGetter: userGetter, tunnels: tunnels},
+		Tunnel:  &TunnelREST{store: store, tunnels: tunnels},
+	}, nil
+}
@@ -0,0 +1,228 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/controller/cluster/registration"
+)
+
+// clusterStrategy 实现了 Cluster 资源在创建、更新、删除时的业务逻辑
+type clusterStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy 创建 Cluster 资源的存储策略
+func NewStrategy(typer runtime.ObjectTyper) clusterStrategy {
+	return clusterStrategy{typer, names.SimpleNameGenerator}
+}
+
+// GetAttrs 返回 Cluster 对象的标签与可用于字段选择器的字段集合
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	cluster, ok := obj.(*clusterkubellmio.Cluster)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a Cluster")
+	}
+	return labels.Set(cluster.Labels), SelectableFields(cluster), nil
+}
+
+// MatchCluster 返回 etcd 存储在 List/Watch 时用于按标签和字段过滤 Cluster 的谓词
+func MatchCluster(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// SelectableFields 返回 Cluster 可用于字段选择器的字段集合，status.ready 由 Ready 条件计算得到
+func SelectableFields(cluster *clusterkubellmio.Cluster) fields.Set {
+	clusterFields := fields.Set{
+		clusterkubellmio.ClusterFieldID:       cluster.Spec.ID,
+		clusterkubellmio.ClusterFieldProvider: cluster.Spec.Provider,
+		clusterkubellmio.ClusterFieldRegion:   cluster.Spec.Region,
+		clusterkubellmio.ClusterFieldReady:    strconv.FormatBool(meta.IsStatusConditionTrue(cluster.Status.Conditions, clusterkubellmio.ClusterConditionReady)),
+	}
+	return generic.MergeFieldsSets(generic.ObjectMetaFieldsSet(&cluster.ObjectMeta, false), clusterFields)
+}
+
+// Indexers 返回 watch cache 的索引，与 membercluster.ClusterIndexers 的字段一致，
+// 按 id、provider、region 与 ready 过滤时不需要遍历所有 Cluster
+func Indexers() *cache.Indexers {
+	return &cache.Indexers{
+		storage.FieldIndex(clusterkubellmio.ClusterFieldID):       fieldIndexFunc(clusterkubellmio.ClusterFieldID),
+		storage.FieldIndex(clusterkubellmio.ClusterFieldProvider): fieldIndexFunc(clusterkubellmio.ClusterFieldProvider),
+		storage.FieldIndex(clusterkubellmio.ClusterFieldRegion):   fieldIndexFunc(clusterkubellmio.ClusterFieldRegion),
+		storage.FieldIndex(clusterkubellmio.ClusterFieldReady):    fieldIndexFunc(clusterkubellmio.ClusterFieldReady),
+	}
+}
+
+// fieldIndexFunc 返回按 SelectableFields 中的字段建立索引的函数
+func fieldIndexFunc(field string) cache.IndexFunc {
+	return func(obj interface{}) ([]string, error) {
+		cluster, ok := obj.(*clusterkubellmio.Cluster)
+		if !ok {
+			return nil, fmt.Errorf("given object is not a Cluster")
+		}
+		return []string{SelectableFields(cluster)[field]}, nil
+	}
+}
+
+// NamespaceScoped Cluster 为集群级资源
+func (clusterStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// GetResetFields 返回主资源更新时会被重置的字段，Status 只能通过 status 子资源修改
+func (clusterStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"cluster.kubellm.io/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("status"),
+		),
+	}
+}
+
+// PrepareForCreate 创建前清空 Status，集群状态只能由控制器通过 status 子资源上报。
+// 由 bootstrap token 创建时记录创建者，只有同一个 token 提交的 CSR 会被批准为该集群的身份。
+func (clusterStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	cluster := obj.(*clusterkubellmio.Cluster)
+	cluster.Status = clusterkubellmio.ClusterStatus{}
+	cluster.Generation = 1
+	setTaintsTimeAdded(cluster.Spec.Taints, nil)
+	if requester := bootstrapper(ctx); requester != nil {
+		if cluster.Annotations == nil {
+			cluster.Annotations = map[string]string{}
+		}
+		cluster.Annotations[registration.BootstrapUserAnnotation] = requester.GetName()
+	}
+}
+
+// PrepareForUpdate 更新主资源时保留旧的 Status，Spec 变化时递增 Generation
+func (clusterStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newCluster := obj.(*clusterkubellmio.Cluster)
+	oldCluster := old.(*clusterkubellmio.Cluster)
+	newCluster.Status = oldCluster.Status
+	setTaintsTimeAdded(newCluster.Spec.Taints, oldCluster.Spec.Taints)
+
+	if !apiequality.Semantic.DeepEqual(newCluster.Spec, oldCluster.Spec) {
+		newCluster.Generation = oldCluster.Generation + 1
+	}
+}
+
+// setTaintsTimeAdded 为未设置 TimeAdded 的 NoExecute 污点补充添加时间，
+// 已存在的污点沿用旧的添加时间，污点管理器据此计算 tolerationSeconds 何时到期
+func setTaintsTimeAdded(taints, oldTaints []corev1.Taint) {
+	now := metav1.Now()
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect != corev1.TaintEffectNoExecute || taint.TimeAdded != nil {
+			continue
+		}
+		taint.TimeAdded = &now
+		for _, old := range oldTaints {
+			if old.MatchTaint(taint) && old.TimeAdded != nil {
+				taint.TimeAdded = old.TimeAdded.DeepCopy()
+				break
+			}
+		}
+	}
+}
+
+// Validate 校验新创建的 Cluster，bootstrap token 只能创建不带连接信息的 Pull 模式集群
+func (clusterStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	cluster := obj.(*clusterkubellmio.Cluster)
+	allErrs := ValidateCluster(cluster)
+	if bootstrapper(ctx) != nil {
+		allErrs = append(allErrs, validateBootstrapCluster(cluster)...)
+	}
+	return allErrs
+}
+
+// WarningsOnCreate 返回创建 Cluster 时的告警信息
+func (clusterStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// AllowCreateOnUpdate 不允许通过 Update 创建 Cluster
+func (clusterStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate 允许不带 resourceVersion 的更新
+func (clusterStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// Canonicalize 规范化对象，Cluster 无需处理
+func (clusterStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate 校验 Cluster 的更新
+func (clusterStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateClusterUpdate(obj.(*clusterkubellmio.Cluster), old.(*clusterkubellmio.Cluster))
+}
+
+// WarningsOnUpdate 返回更新 Cluster 时的告警信息
+func (clusterStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// clusterStatusStrategy 是 status 子资源的更新策略，只允许修改 Status
+type clusterStatusStrategy struct {
+	clusterStrategy
+}
+
+// NewStatusStrategy 创建 Cluster status 子资源的更新策略
+func NewStatusStrategy(strategy clusterStrategy) clusterStatusStrategy {
+	return clusterStatusStrategy{strategy}
+}
+
+// GetResetFields 返回 status 子资源更新时会被重置的字段
+func (clusterStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"cluster.kubellm.io/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+// PrepareForUpdate 通过 status 子资源更新时丢弃对 Spec 的修改，唯一的例外是尚未设置的 spec.id。
+// id 由状态控制器或 kubellm-agent 从成员集群收集，它们只有 status 子资源的修改权限，
+// 因此 id 为空时接受 status 更新中携带的 id，设置后便不再变化。
+func (clusterStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newCluster := obj.(*clusterkubellmio.Cluster)
+	oldCluster := old.(*clusterkubellmio.Cluster)
+	id := newCluster.Spec.ID
+	newCluster.Spec = oldCluster.Spec
+	if len(oldCluster.Spec.ID) == 0 && len(id) > 0 {
+		newCluster.Spec.ID = id
+		newCluster.Generation = oldCluster.Generation + 1
+	}
+}
+
+// ValidateUpdate 校验 status 子资源的更新
+func (clusterStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateClusterStatusUpdate(obj.(*clusterkubellmio.Cluster), old.(*clusterkubellmio.Cluster))
+}
+
+// WarningsOnUpdate 返回更新 status 子资源时的告警信息
+func (clusterStatusStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
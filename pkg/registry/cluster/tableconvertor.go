@@ -0,0 +1,64 @@
+package cluster
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/registry/table"
+)
+
+// clusterColumns 与 Cluster 类型上的 +kubebuilder:printcolumn 标记保持一致
+var clusterColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Format: "name", Description: table.NameDescription},
+	{Name: "Version", Type: "string", Description: "成员集群的 Kubernetes 版本"},
+	{Name: "Mode", Type: "string", Description: "成员集群的同步模式"},
+	{Name: "Ready", Type: "string", Description: "成员集群是否就绪"},
+	{Name: "ReadyNodes", Type: "integer", Description: "成员集群中就绪的节点数"},
+	{Name: "TotalNodes", Type: "integer", Description: "成员集群中的节点总数"},
+	{Name: "GPU", Type: "string", Description: "成员集群中可分配的 GPU 数量"},
+	{Name: "Region", Type: "string", Description: "成员集群所在的地域"},
+	{Name: "Age", Type: "date", Description: table.AgeDescription},
+}
+
+// NewTableConvertor 创建 Cluster 的 TableConvertor
+func NewTableConvertor() *table.Convertor {
+	return table.NewConvertor(clusterColumns, clusterRow)
+}
+
+func clusterRow(obj runtime.Object, name, age string) ([]interface{}, error) {
+	cluster, ok := obj.(*clusterkubellmio.Cluster)
+	if !ok {
+		return nil, fmt.Errorf("given object is not a Cluster")
+	}
+
+	var ready interface{}
+	if condition := meta.FindStatusCondition(cluster.Status.Conditions, clusterkubellmio.ClusterConditionReady); condition != nil {
+		ready = string(condition.Status)
+	}
+	var readyNodes, totalNodes interface{}
+	if summary := cluster.Status.NodeSummary; summary != nil {
+		readyNodes, totalNodes = int64(summary.ReadyNum), int64(summary.TotalNum)
+	}
+	var gpu interface{}
+	if summary := cluster.Status.ResourceSummary; summary != nil {
+		if quantity, ok := summary.Allocatable[clusterkubellmio.ResourceNvidiaGPU]; ok {
+			gpu = quantity.String()
+		}
+	}
+
+	return []interface{}{
+		name,
+		table.StringCell(cluster.Status.KubernetesVersion),
+		table.StringCell(string(cluster.Spec.SyncMode)),
+		ready,
+		readyNodes,
+		totalNodes,
+		gpu,
+		table.StringCell(cluster.Spec.Region),
+		age,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/proxy"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/util/tunnel"
+)
+
+// TunnelREST 实现了 Cluster 的 tunnel 子资源。
+// Pull 模式成员集群中的 kubellm-agent 通过 upgrade 请求
+// /apis/cluster.kubellm.io/v1alpha1/clusters/{name}/tunnel 建立反向隧道，proxy 子资源经由该隧道访问成员集群。
+type TunnelREST struct {
+	store   *genericregistry.Store
+	tunnels *tunnel.Server
+}
+
+var _ rest.Connecter = &TunnelREST{}
+
+// New 创建一个新的 Cluster 对象
+func (r *TunnelREST) New() runtime.Object {
+	return &clusterkubellmio.Cluster{}
+}
+
+// Destroy 在关闭时清理资源，底层存储由主资源负责释放
+func (r *TunnelREST) Destroy() {
+}
+
+// ConnectMethods 返回 tunnel 子资源支持的 HTTP 方法
+func (r *TunnelREST) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// NewConnectOptions tunnel 子资源没有选项
+func (r *TunnelREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect 接管 agent 的 upgrade 请求，只允许 Pull 模式的集群建立隧道。
+// 隧道只保存在活跃的副本上，其余副本把 upgrade 请求转发给活跃的副本。
+func (r *TunnelREST) Connect(ctx context.Context, id string, options runtime.Object, responder rest.Responder) (http.Handler, error) {
+	obj, err := r.store.Get(ctx, id, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	cluster := obj.(*clusterkubellmio.Cluster)
+	if cluster.Spec.SyncMode != clusterkubellmio.Pull {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("cluster %s is not in %s mode", cluster.Name, clusterkubellmio.Pull))
+	}
+
+	if !r.tunnels.Active() {
+		handler, ok := r.tunnels.RelayTunnel(cluster.Name, proxy.NewErrorResponder(responder))
+		if !ok {
+			// 尚未选出活跃的副本，agent 稍后重试
+			return nil, apierrors.NewServiceUnavailable("no active kubellm-apiserver replica serves tunnels")
+		}
+		return handler, nil
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if err := r.tunnels.Accept(req.Context(), cluster.Name, rw, req); err != nil {
+			responder.Error(apierrors.NewBadRequest(err.Error()))
+		}
+	}), nil
+}
@@ -0,0 +1,306 @@
+package cluster
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	netutils "k8s.io/utils/net"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/util/gpu"
+)
+
+var supportedTaintEffects = sets.New(
+	string(corev1.TaintEffectNoSchedule),
+	string(corev1.TaintEffectPreferNoSchedule),
+	string(corev1.TaintEffectNoExecute),
+)
+
+var supportedProxySchemes = sets.New("http", "https", "socks5")
+
+var supportedSyncModes = sets.New(string(clusterkubellmio.Push), string(clusterkubellmio.Pull))
+
+var supportedResourceModelNames = sets.New(
+	string(corev1.ResourceCPU),
+	string(corev1.ResourceMemory),
+	string(corev1.ResourceStorage),
+	string(corev1.ResourceEphemeralStorage),
+)
+
+// supportedResourceModelNameValues 用于错误提示，GPU 扩展资源无法一一列举，以通配形式给出
+var supportedResourceModelNameValues = append(sets.List(supportedResourceModelNames), "<vendor>/gpu", clusterkubellmio.ResourceNvidiaMIGPrefix+"<profile>")
+
+// ValidateCluster 校验 Cluster 对象
+func ValidateCluster(cluster *clusterkubellmio.Cluster) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&cluster.ObjectMeta, false, path.ValidatePathSegmentName, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateClusterSpec(&cluster.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateClusterUpdate 校验 Cluster 的更新
+func ValidateClusterUpdate(newCluster, oldCluster *clusterkubellmio.Cluster) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&newCluster.ObjectMeta, &oldCluster.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateClusterSpec(&newCluster.Spec, field.NewPath("spec"))...)
+	// id 由注册流程或状态控制器自动收集，设置后不允许修改
+	if len(oldCluster.Spec.ID) > 0 {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newCluster.Spec.ID, oldCluster.Spec.ID, field.NewPath("spec", "id"))...)
+	}
+	return allErrs
+}
+
+// ValidateClusterStatusUpdate 校验 Cluster status 子资源的更新
+func ValidateClusterStatusUpdate(newCluster, oldCluster *clusterkubellmio.Cluster) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&newCluster.ObjectMeta, &oldCluster.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, metavalidation.ValidateConditions(newCluster.Status.Conditions, field.NewPath("status", "conditions"))...)
+	return allErrs
+}
+
+// ValidateClusterSpec 校验 ClusterSpec，确保控制器拿到的连接信息是可用的
+func ValidateClusterSpec(spec *clusterkubellmio.ClusterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(spec.SyncMode) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("syncMode"), ""))
+	} else if !supportedSyncModes.Has(string(spec.SyncMode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("syncMode"), spec.SyncMode, sets.List(supportedSyncModes)))
+	}
+
+	if len(spec.APIEndpoint) > 0 {
+		allErrs = append(allErrs, validateAPIEndpoint(spec.APIEndpoint, fldPath.Child("apiEndpoint"))...)
+	}
+
+	if len(spec.ProxyURL) > 0 {
+		allErrs = append(allErrs, validateProxyURL(spec.ProxyURL, fldPath.Child("proxyURL"))...)
+	}
+	if len(spec.ProxyHeader) > 0 {
+		if len(spec.ProxyURL) == 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("proxyHeader"), "may only be set when proxyURL is set"))
+		}
+		for key := range spec.ProxyHeader {
+			for _, msg := range validation.IsHTTPHeaderName(key) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("proxyHeader").Key(key), key, msg))
+			}
+		}
+	}
+
+	if spec.SecretRef != nil {
+		allErrs = append(allErrs, validateLocalSecretReference(spec.SecretRef, fldPath.Child("secretRef"))...)
+	}
+	if spec.ImpersonatorSecretRef != nil {
+		allErrs = append(allErrs, validateLocalSecretReference(spec.ImpersonatorSecretRef, fldPath.Child("impersonatorSecretRef"))...)
+	}
+
+	allErrs = append(allErrs, validateTaints(spec.Taints, fldPath.Child("taints"))...)
+	allErrs = append(allErrs, validateResourceModels(spec.ResourceModels, fldPath.Child("resourceModels"))...)
+
+	return allErrs
+}
+
+// validateAPIEndpoint 校验 APIEndpoint，支持 hostname、hostname:port、IP、IP:port，
+// 以及从 kubeconfig 中直接拷贝过来的 http(s)://host[:port] 形式
+func validateAPIEndpoint(endpoint string, fldPath *field.Path) field.ErrorList {
+	hostport := endpoint
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return field.ErrorList{field.Invalid(fldPath, endpoint, err.Error())}
+		}
+		if u.Scheme != "https" && u.Scheme != "http" {
+			return field.ErrorList{field.Invalid(fldPath, endpoint, "scheme must be http or https")}
+		}
+		if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+			return field.ErrorList{field.Invalid(fldPath, endpoint, "must not contain user info, query or fragment")}
+		}
+		hostport = u.Host
+	}
+	if err := validateHostPort(hostport); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, endpoint, err.Error())}
+	}
+	return nil
+}
+
+// validateHostPort 校验 host 或 host:port 形式的地址，host 可以是 IP 或 DNS 名称
+func validateHostPort(hostport string) error {
+	host := hostport
+	if h, port, err := net.SplitHostPort(hostport); err == nil {
+		portNum, err := strconv.Atoi(port)
+		if err != nil || len(validation.IsValidPortNum(portNum)) > 0 {
+			return fmt.Errorf("port must be a number between 1 and 65535")
+		}
+		host = h
+	}
+	if len(host) == 0 {
+		return fmt.Errorf("host must not be empty")
+	}
+	if netutils.ParseIPSloppy(strings.Trim(host, "[]")) != nil {
+		return nil
+	}
+	if msgs := validation.IsDNS1123Subdomain(strings.ToLower(host)); len(msgs) > 0 {
+		return fmt.Errorf("host must be a valid IP address or DNS name: %s", strings.Join(msgs, ", "))
+	}
+	return nil
+}
+
+// validateProxyURL 校验代理地址必须是可解析的 http、https 或 socks5 URL
+func validateProxyURL(proxyURL string, fldPath *field.Path) field.ErrorList {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, proxyURL, err.Error())}
+	}
+	if !supportedProxySchemes.Has(u.Scheme) {
+		return field.ErrorList{field.NotSupported(fldPath, u.Scheme, sets.List(supportedProxySchemes))}
+	}
+	if len(u.Host) == 0 {
+		return field.ErrorList{field.Invalid(fldPath, proxyURL, "host must not be empty")}
+	}
+	return nil
+}
+
+// validateLocalSecretReference 校验 Secret 引用，命名空间与名称都必须填写
+func validateLocalSecretReference(ref *clusterkubellmio.LocalSecretReference, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(ref.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.ValidateNamespaceName(ref.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), ref.Namespace, msg))
+		}
+	}
+	if len(ref.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(ref.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), ref.Name, msg))
+		}
+	}
+	return allErrs
+}
+
+// validateTaints 校验集群污点，规则与 Node 上的污点保持一致
+func validateTaints(taints []corev1.Taint, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := sets.New[string]()
+	for i, taint := range taints {
+		idxPath := fldPath.Index(i)
+
+		if len(taint.Key) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("key"), ""))
+		} else {
+			for _, msg := range validation.IsQualifiedName(taint.Key) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), taint.Key, msg))
+			}
+		}
+		for _, msg := range validation.IsValidLabelValue(taint.Value) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), taint.Value, msg))
+		}
+
+		if len(taint.Effect) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("effect"), ""))
+		} else if !supportedTaintEffects.Has(string(taint.Effect)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), taint.Effect, sets.List(supportedTaintEffects)))
+		}
+
+		// 同一个 key 与 effect 的组合只能出现一次
+		id := taint.Key + ":" + string(taint.Effect)
+		if seen.Has(id) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect)))
+			continue
+		}
+		seen.Insert(id)
+	}
+
+	return allErrs
+}
+
+// validateResourceModels 校验资源模型：等级必须严格递增，每个等级包含相同的资源，
+// 每个区间满足 Max > Min，且相邻等级中同一资源的区间首尾相接（上一等级的 Max 等于下一等级的 Min）
+func validateResourceModels(models []clusterkubellmio.ResourceModel, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	grades := sets.New[uint]()
+	var firstNames sets.Set[string]
+	for i, model := range models {
+		idxPath := fldPath.Index(i)
+
+		if grades.Has(model.Grade) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("grade"), int64(model.Grade)))
+		} else if i > 0 && model.Grade < models[i-1].Grade {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("grade"), int64(model.Grade), "grades must be in increasing order"))
+		}
+		grades.Insert(model.Grade)
+
+		if len(model.Ranges) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ranges"), ""))
+			continue
+		}
+
+		names := sets.New[string]()
+		for j, r := range model.Ranges {
+			rangePath := idxPath.Child("ranges").Index(j)
+			name := string(r.Name)
+			if !supportedResourceModelNames.Has(name) && !gpu.IsGPUResourceName(r.Name) {
+				allErrs = append(allErrs, field.NotSupported(rangePath.Child("name"), r.Name, supportedResourceModelNameValues))
+				continue
+			}
+			if msgs := validation.IsQualifiedName(name); len(msgs) > 0 {
+				allErrs = append(allErrs, field.Invalid(rangePath.Child("name"), r.Name, strings.Join(msgs, "; ")))
+				continue
+			}
+			if names.Has(name) {
+				allErrs = append(allErrs, field.Duplicate(rangePath.Child("name"), r.Name))
+				continue
+			}
+			names.Insert(name)
+
+			if r.Max.Cmp(r.Min) <= 0 {
+				allErrs = append(allErrs, field.Invalid(rangePath, fmt.Sprintf("[%s, %s)", r.Min.String(), r.Max.String()), "max must be greater than min"))
+			}
+			if i == 0 {
+				continue
+			}
+			prev, ok := findResourceModelRange(models[i-1].Ranges, r.Name)
+			if !ok {
+				continue
+			}
+			// 区间为左闭右开，相邻等级首尾相接才能保证每个数值恰好落在一个等级中
+			switch cmp := r.Min.Cmp(prev.Max); {
+			case cmp > 0:
+				allErrs = append(allErrs, field.Invalid(rangePath, r.Min.String(),
+					fmt.Sprintf("min leaves a gap after the max %s of the previous grade", prev.Max.String())))
+			case cmp < 0:
+				allErrs = append(allErrs, field.Invalid(rangePath, r.Min.String(),
+					fmt.Sprintf("min overlaps with the max %s of the previous grade", prev.Max.String())))
+			}
+		}
+
+		// 所有等级必须描述同一组资源，否则节点无法在等级之间比较
+		if firstNames == nil {
+			firstNames = names
+		} else if !names.Equal(firstNames) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ranges"), sets.List(names),
+				fmt.Sprintf("must contain the same resources as the first grade: %s", strings.Join(sets.List(firstNames), ", "))))
+		}
+	}
+
+	return allErrs
+}
+
+func findResourceModelRange(ranges []clusterkubellmio.ResourceModelRange, name corev1.ResourceName) (clusterkubellmio.ResourceModelRange, bool) {
+	for _, r := range ranges {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return clusterkubellmio.ResourceModelRange{}, false
+}
@@ -0,0 +1,155 @@
+package cluster
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	clusterkubellmio "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io"
+)
+
+func newResourceModelRange(name corev1.ResourceName, min, max string) clusterkubellmio.ResourceModelRange {
+	return clusterkubellmio.ResourceModelRange{Name: name, Min: resource.MustParse(min), Max: resource.MustParse(max)}
+}
+
+func newResourceModel(grade uint, ranges ...clusterkubellmio.ResourceModelRange) clusterkubellmio.ResourceModel {
+	return clusterkubellmio.ResourceModel{Grade: grade, Ranges: ranges}
+}
+
+func TestValidateResourceModels(t *testing.T) {
+	tests := []struct {
+		name   string
+		models []clusterkubellmio.ResourceModel
+		// expected 是期望的错误类型与字段，顺序与校验顺序一致
+		expected field.ErrorList
+	}{
+		{
+			name:   "empty",
+			models: nil,
+		},
+		{
+			name: "contiguous ranges",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "0", "4"), newResourceModelRange(corev1.ResourceMemory, "0", "16Gi")),
+				newResourceModel(1, newResourceModelRange(corev1.ResourceCPU, "4", "16"), newResourceModelRange(corev1.ResourceMemory, "16Gi", "64Gi")),
+				// 资源的顺序可以不同
+				newResourceModel(2, newResourceModelRange(corev1.ResourceMemory, "64Gi", "128Gi"), newResourceModelRange(corev1.ResourceCPU, "16", "32")),
+			},
+		},
+		{
+			name: "grade numbers need not be contiguous",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "0", "4")),
+				newResourceModel(3, newResourceModelRange(corev1.ResourceCPU, "4", "16")),
+				newResourceModel(8, newResourceModelRange(corev1.ResourceCPU, "16", "32")),
+			},
+		},
+		{
+			name: "gpu resources",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(clusterkubellmio.ResourceNvidiaGPU, "0", "1")),
+				newResourceModel(1, newResourceModelRange(clusterkubellmio.ResourceNvidiaGPU, "1", "8")),
+			},
+		},
+		{
+			name: "duplicate grade",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "0", "4")),
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "4", "16")),
+			},
+			expected: field.ErrorList{field.Duplicate(field.NewPath("resourceModels").Index(1).Child("grade"), nil)},
+		},
+		{
+			name: "unsorted grades",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(1, newResourceModelRange(corev1.ResourceCPU, "0", "4")),
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "4", "16")),
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("resourceModels").Index(1).Child("grade"), nil, "")},
+		},
+		{
+			name: "gap between ranges",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "0", "4")),
+				newResourceModel(1, newResourceModelRange(corev1.ResourceCPU, "8", "16")),
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("resourceModels").Index(1).Child("ranges").Index(0), nil, "")},
+		},
+		{
+			name: "overlapping ranges",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "0", "4")),
+				newResourceModel(1, newResourceModelRange(corev1.ResourceCPU, "2", "16")),
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("resourceModels").Index(1).Child("ranges").Index(0), nil, "")},
+		},
+		{
+			name: "unsorted ranges",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "16", "32")),
+				newResourceModel(1, newResourceModelRange(corev1.ResourceCPU, "0", "4")),
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("resourceModels").Index(1).Child("ranges").Index(0), nil, "")},
+		},
+		{
+			name: "max not greater than min",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "4", "4")),
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("resourceModels").Index(0).Child("ranges").Index(0), nil, "")},
+		},
+		{
+			name: "missing ranges",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0),
+			},
+			expected: field.ErrorList{field.Required(field.NewPath("resourceModels").Index(0).Child("ranges"), "")},
+		},
+		{
+			name: "missing resource in a later grade",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "0", "4"), newResourceModelRange(corev1.ResourceMemory, "0", "16Gi")),
+				newResourceModel(1, newResourceModelRange(corev1.ResourceCPU, "4", "16")),
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("resourceModels").Index(1).Child("ranges"), nil, "")},
+		},
+		{
+			name: "extra resource in a later grade",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "0", "4")),
+				newResourceModel(1, newResourceModelRange(corev1.ResourceCPU, "4", "16"), newResourceModelRange(corev1.ResourceMemory, "0", "16Gi")),
+			},
+			expected: field.ErrorList{field.Invalid(field.NewPath("resourceModels").Index(1).Child("ranges"), nil, "")},
+		},
+		{
+			name: "duplicate resource",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourceCPU, "0", "4"), newResourceModelRange(corev1.ResourceCPU, "0", "8")),
+			},
+			expected: field.ErrorList{field.Duplicate(field.NewPath("resourceModels").Index(0).Child("ranges").Index(1).Child("name"), nil)},
+		},
+		{
+			name: "unsupported resource",
+			models: []clusterkubellmio.ResourceModel{
+				newResourceModel(0, newResourceModelRange(corev1.ResourcePods, "0", "110")),
+			},
+			expected: field.ErrorList{field.NotSupported[string](field.NewPath("resourceModels").Index(0).Child("ranges").Index(0).Child("name"), nil, nil)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateResourceModels(tt.models, field.NewPath("resourceModels"))
+			if len(errs) != len(tt.expected) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tt.expected), len(errs), errs)
+			}
+			for i := range errs {
+				if errs[i].Type != tt.expected[i].Type || errs[i].Field != tt.expected[i].Field {
+					t.Errorf("expected error %s on %s, got %v", tt.expected[i].Type, tt.expected[i].Field, errs[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+// ApprovalREST 实现了 User 的 approve 与 reject 子资源，管理员通过
+// POST /apis/iam.kubellm.io/v1alpha1/users/{name}/approve 或 /reject 审批自注册的用户。
+// 请求的 verb 为 create，授予 users/approve 与 users/reject 子资源的 create 权限即可审批，不需要修改 users/status 的权限。
+// 子资源修改 Approved 条件，并把等待审批的用户的 State 设置为审批后的初始状态，之后由 kubellm-controller 推导。
+type ApprovalREST struct {
+	status *StatusREST
+	// approve 为 true 时通过审批，否则拒绝
+	approve bool
+	clock   clock.Clock
+}
+
+var _ rest.Connecter = &ApprovalREST{}
+
+// maxApprovalMessageLength 是审批意见的最大长度，与 Status.Message 的长度限制一致
+const maxApprovalMessageLength = 1024
+
+// New 返回 approve 与 reject 子资源的选项对象
+func (r *ApprovalREST) New() runtime.Object {
+	return &iamkubellmio.UserApprovalOptions{}
+}
+
+// Destroy 在关闭时清理资源，底层存储由主资源负责释放
+func (r *ApprovalREST) Destroy() {
+}
+
+// ConnectMethods 返回 approve 与 reject 子资源支持的 HTTP 方法
+func (r *ApprovalREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions 返回 approve 与 reject 子资源的选项对象，审批意见通过查询参数 message 传递
+func (r *ApprovalREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &iamkubellmio.UserApprovalOptions{}, false, ""
+}
+
+// Connect 修改 Approved 条件并返回修改后的 User。
+// 重复通过审批不会修改 User；已经通过审批的用户不能再被拒绝，应设置 Spec.LoginDisabled 禁止其登录。
+func (r *ApprovalREST) Connect(ctx context.Context, id string, options runtime.Object, responder rest.Responder) (http.Handler, error) {
+	approvalOpts, ok := options.(*iamkubellmio.UserApprovalOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options object: %#v", options)
+	}
+	if len(approvalOpts.Message) > maxApprovalMessageLength {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("message must be no more than %d characters", maxApprovalMessageLength))
+	}
+
+	approver := "unknown"
+	if requester, ok := genericapirequest.UserFrom(ctx); ok {
+		approver = requester.GetName()
+	}
+	condition := metav1.Condition{
+		Type:               iamkubellmio.UserConditionApproved,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Time{Time: r.clock.Now()},
+		Reason:             iamkubellmio.UserReasonApproved,
+		Message:            fmt.Sprintf("Approved by %s", approver),
+	}
+	if !r.approve {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = iamkubellmio.UserReasonRejected
+		condition.Message = fmt.Sprintf("Rejected by %s", approver)
+	}
+	if len(approvalOpts.Message) > 0 {
+		condition.Message += ": " + approvalOpts.Message
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		user, err := r.status.mutate(ctx, id, func(user *iamkubellmio.User) error {
+			current := apimeta.FindStatusCondition(user.Status.Conditions, iamkubellmio.UserConditionApproved)
+			if r.approve && current != nil && current.Status == metav1.ConditionTrue {
+				return nil
+			}
+			// 没有 Approved 条件的用户由管理员创建，视为已通过审批
+			if !r.approve && (current == nil || current.Status == metav1.ConditionTrue) {
+				return apierrors.NewConflict(iamkubellmio.Resource("users"), id,
+					fmt.Errorf("user is already approved, set spec.loginDisabled to block it from logging in"))
+			}
+			condition.ObservedGeneration = user.Generation
+			apimeta.SetStatusCondition(&user.Status.Conditions, condition)
+			if user.Status.State == iamkubellmio.UserPendingApproval {
+				setApprovalState(user, condition)
+			}
+			return nil
+		})
+		if err != nil {
+			responder.Error(err)
+			return
+		}
+		responder.Object(http.StatusOK, user)
+	}), nil
+}
+
+// setApprovalState 设置审批后的初始状态：通过审批为 Active，拒绝为 Disabled。
+// 等待审批的优先级高于 AuthLimitExceeded 与 PasswordExpired，这些条件由 kubellm-controller 随后推导。
+func setApprovalState(user *iamkubellmio.User, condition metav1.Condition) {
+	user.Status.State = iamkubellmio.UserActive
+	user.Status.Reason, user.Status.Message = "", ""
+	if condition.Status == metav1.ConditionFalse {
+		user.Status.State = iamkubellmio.UserDisabled
+		user.Status.Reason, user.Status.Message = condition.Reason, condition.Message
+	}
+	user.Status.LastTransitionTime = &condition.LastTransitionTime
+}
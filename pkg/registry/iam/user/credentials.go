@@ -0,0 +1,184 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/utils/ptr"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+var (
+	// ErrInvalidCredentials 表示用户不存在、没有设置密码或密码错误，三者对调用方不做区分以免泄露用户是否存在
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	// ErrLoginNotAllowed 表示用户当前不允许登录，例如状态不是 Active 或被管理员禁止登录
+	ErrLoginNotAllowed = errors.New("user is not allowed to log in")
+	// ErrRefreshTokenRevoked 表示刷新令牌所在的会话已经过期或被撤销，或者刷新令牌已经使用过
+	ErrRefreshTokenRevoked = errors.New("the refresh token has been revoked")
+)
+
+// maxRefreshTokenSessions 是每个用户同时保留的刷新令牌会话数量，超过时丢弃最早过期的会话
+const maxRefreshTokenSessions = 20
+
+// dummyPasswordHash 用于用户不存在时仍然执行一次 bcrypt 比较，使响应时间无法区分用户是否存在
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("kubellm-dummy-password"), bcrypt.DefaultCost)
+	return hashed
+})
+
+// Credentials 直接读写 User 存储，读取结果包含密码哈希，只供 kubellm-apiserver 内部的登录逻辑使用，不经过鉴权。
+// 登录请求不属于任何 API 资源，因此在访问存储前为 ctx 补充集群级资源的空命名空间。
+type Credentials struct {
+	// store 不设置 Decorator，读取时保留密码哈希
+	store *genericregistry.Store
+	// status 用于记录登录信息，与 status 子资源一样只修改 Status，但可以修改刷新令牌会话
+	status *StatusREST
+}
+
+// Get 读取 User，结果中包含密码哈希
+func (c *Credentials) Get(ctx context.Context, name string) (*iamkubellmio.User, error) {
+	obj, err := c.store.Get(genericapirequest.WithNamespace(ctx, metav1.NamespaceNone), name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*iamkubellmio.User), nil
+}
+
+// Authenticate 先校验用户名与密码，成功后再检查用户是否允许登录，因此只有知道密码的调用方才能看到用户的状态。
+// 密码错误返回 ErrInvalidCredentials，用户不允许登录返回包装了 ErrLoginNotAllowed 的错误。
+// 因登录失败次数过多被限制的用户无论密码是否正确都返回 ErrInvalidCredentials，避免在限制期间通过不同的响应确认猜中的密码。
+func (c *Credentials) Authenticate(ctx context.Context, name, password string) (*iamkubellmio.User, error) {
+	user, err := c.Get(ctx, name)
+	if apierrors.IsNotFound(err) {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(user.Spec.Password) == 0 {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Spec.Password), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+	if authLimitExceeded(user) {
+		return nil, ErrInvalidCredentials
+	}
+	if err := LoginAllowed(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// LoginAllowed 检查用户是否允许登录：Status.State 必须为 Active，且管理员没有设置 Spec.LoginDisabled。
+// 创建与审批时已经设置了初始的 State，State 为空说明状态未知，同样拒绝登录。
+// kubellm-controller 推导出新的 State 之前，Spec.LoginDisabled 与 AuthLimitExceeded 条件已经生效。
+func LoginAllowed(user *iamkubellmio.User) error {
+	if ptr.Deref(user.Spec.LoginDisabled, false) {
+		return fmt.Errorf("%w: login is disabled by the administrator", ErrLoginNotAllowed)
+	}
+	if authLimitExceeded(user) {
+		return errAuthLimitExceeded
+	}
+	if user.Status.State != iamkubellmio.UserActive {
+		state := user.Status.State
+		if len(state) == 0 {
+			state = "Unknown"
+		}
+		return fmt.Errorf("%w: user is in %s state", ErrLoginNotAllowed, state)
+	}
+	return nil
+}
+
+var errAuthLimitExceeded = fmt.Errorf("%w: too many failed login attempts, try again later", ErrLoginNotAllowed)
+
+// authLimitExceeded 判断用户是否因登录失败次数过多被限制登录
+func authLimitExceeded(user *iamkubellmio.User) bool {
+	return user.Status.State == iamkubellmio.UserAuthLimitExceeded ||
+		apimeta.IsStatusConditionTrue(user.Status.Conditions, iamkubellmio.UserConditionAuthLimitExceeded)
+}
+
+// RecordLogin 在 Status 中记录最后一次成功登录的时间与客户端 IP 以及本次登录开始的刷新令牌会话，并清零连续登录失败的计数
+func (c *Credentials) RecordLogin(ctx context.Context, name, clientIP string, loginTime time.Time, session iamkubellmio.RefreshTokenSession) error {
+	_, err := c.updateStatus(ctx, name, func(user *iamkubellmio.User) {
+		user.Status.LastLoginTime = &metav1.Time{Time: loginTime}
+		user.Status.LastLoginIP = clientIP
+		user.Status.FailedLoginAttempts = nil
+		user.Status.FirstFailedLoginTime = nil
+		user.Status.LastFailedLoginTime = nil
+
+		sessions := append(activeSessions(user.Status.RefreshTokenSessions, loginTime), session)
+		if len(sessions) > maxRefreshTokenSessions {
+			slices.SortFunc(sessions, func(a, b iamkubellmio.RefreshTokenSession) int {
+				return b.ExpirationTime.Compare(a.ExpirationTime.Time)
+			})
+			sessions = sessions[:maxRefreshTokenSessions]
+		}
+		user.Status.RefreshTokenSessions = sessions
+	})
+	return err
+}
+
+// RotateRefreshToken 把会话 next.ID 中当前有效的刷新令牌从 tokenID 轮换为 next.TokenID。
+// 会话不存在时返回 ErrRefreshTokenRevoked；tokenID 不是会话中当前有效的刷新令牌时，说明已经使用过的刷新令牌被再次使用，
+// 可能已经泄露，撤销整个会话后同样返回 ErrRefreshTokenRevoked，持有最新刷新令牌的一方也需要重新登录。
+func (c *Credentials) RotateRefreshToken(ctx context.Context, name, tokenID string, next iamkubellmio.RefreshTokenSession, now time.Time) error {
+	var reused bool
+	_, err := c.status.mutate(ctx, name, func(user *iamkubellmio.User) error {
+		sessions := activeSessions(user.Status.RefreshTokenSessions, now)
+		i := slices.IndexFunc(sessions, func(session iamkubellmio.RefreshTokenSession) bool {
+			return session.ID == next.ID
+		})
+		if i < 0 {
+			return ErrRefreshTokenRevoked
+		}
+		// 冲突重试时重新判断，只以最后一次执行的结果为准
+		reused = sessions[i].TokenID != tokenID
+		if reused {
+			user.Status.RefreshTokenSessions = slices.Delete(sessions, i, i+1)
+			return nil
+		}
+		sessions[i] = next
+		user.Status.RefreshTokenSessions = sessions
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if reused {
+		return fmt.Errorf("%w: it has already been used, the session is revoked", ErrRefreshTokenRevoked)
+	}
+	return nil
+}
+
+// activeSessions 返回尚未过期的刷新令牌会话，结果不与 sessions 共享底层数组
+func activeSessions(sessions []iamkubellmio.RefreshTokenSession, now time.Time) []iamkubellmio.RefreshTokenSession {
+	var active []iamkubellmio.RefreshTokenSession
+	for _, session := range sessions {
+		if session.ExpirationTime.After(now) {
+			active = append(active, session)
+		}
+	}
+	return active
+}
+
+// updateStatus 在存储中的最新对象上执行 mutate 并写回 Status
+func (c *Credentials) updateStatus(ctx context.Context, name string, mutate func(user *iamkubellmio.User)) (*iamkubellmio.User, error) {
+	return c.status.mutate(ctx, name, func(user *iamkubellmio.User) error {
+		mutate(user)
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+func TestLoginAllowed(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          iamkubellmio.UserSpec
+		status        iamkubellmio.UserStatus
+		expectAllowed bool
+	}{
+		{
+			name:          "active",
+			status:        iamkubellmio.UserStatus{State: iamkubellmio.UserActive},
+			expectAllowed: true,
+		},
+		{
+			name:   "empty state",
+			status: iamkubellmio.UserStatus{},
+		},
+		{
+			name:   "pending approval",
+			status: iamkubellmio.UserStatus{State: iamkubellmio.UserPendingApproval},
+		},
+		{
+			name:   "locked",
+			status: iamkubellmio.UserStatus{State: iamkubellmio.UserLocked},
+		},
+		{
+			name:   "login disabled before the state is derived",
+			spec:   iamkubellmio.UserSpec{LoginDisabled: ptr.To(true)},
+			status: iamkubellmio.UserStatus{State: iamkubellmio.UserActive},
+		},
+		{
+			name: "auth limit exceeded before the state is derived",
+			status: iamkubellmio.UserStatus{
+				State: iamkubellmio.UserActive,
+				Conditions: []metav1.Condition{
+					{Type: iamkubellmio.UserConditionAuthLimitExceeded, Status: metav1.ConditionTrue},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := LoginAllowed(&iamkubellmio.User{Spec: tt.spec, Status: tt.status})
+			if tt.expectAllowed && err != nil {
+				t.Errorf("expected login to be allowed, got %v", err)
+			}
+			if !tt.expectAllowed && !errors.Is(err, ErrLoginNotAllowed) {
+				t.Errorf("expected ErrLoginNotAllowed, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+// ReasonTooManyFailedLogins 是用户因登录失败次数过多被限制登录时 AuthLimitExceeded 条件与 Status.Reason 的值
+const ReasonTooManyFailedLogins = "TooManyFailedLoginAttempts"
+
+// LockoutPolicy 定义登录失败多少次后限制用户登录
+type LockoutPolicy struct {
+	// MaxFailedAttempts 是统计窗口内触发限制的失败次数，0 表示不限制
+	MaxFailedAttempts int32
+	// FailureWindow 是统计窗口，距离窗口中第一次失败超过该时间后重新开始计数
+	FailureWindow time.Duration
+}
+
+// RecordLoginFailure 增加用户在统计窗口内登录失败的计数，达到 policy 的阈值时将 AuthLimitExceeded 条件设置为 True，
+// 返回本次失败是否触发了限制。计数基于 etcd 的比较并交换更新，多个 kubellm-apiserver 副本同时处理同一用户的失败登录时不会丢失计数。
+// 条件为 True 时 Authenticate 对任何密码都返回 ErrInvalidCredentials，State 由 kubellm-controller 按优先级推导，
+// 解除限制也由 kubellm-controller 在冷却时间过后完成。
+func (c *Credentials) RecordLoginFailure(ctx context.Context, name string, failureTime time.Time, policy LockoutPolicy) (bool, error) {
+	locked := false
+	_, err := c.updateStatus(ctx, name, func(user *iamkubellmio.User) {
+		locked = false
+		status := &user.Status
+		// 限制期间的登录无论密码是否正确都视为失败，但不再计数，避免推迟解除限制的时间
+		if apimeta.IsStatusConditionTrue(status.Conditions, iamkubellmio.UserConditionAuthLimitExceeded) {
+			return
+		}
+
+		// 窗口从第一次失败开始，之后的失败不会延长窗口，因此只统计 FailureWindow 内发生的失败
+		attempts := ptr.Deref(status.FailedLoginAttempts, 0)
+		if status.FirstFailedLoginTime == nil || failureTime.Sub(status.FirstFailedLoginTime.Time) > policy.FailureWindow {
+			attempts = 0
+			status.FirstFailedLoginTime = &metav1.Time{Time: failureTime}
+		}
+		attempts++
+		status.FailedLoginAttempts = ptr.To(attempts)
+		status.LastFailedLoginTime = &metav1.Time{Time: failureTime}
+
+		if policy.MaxFailedAttempts == 0 || attempts < policy.MaxFailedAttempts {
+			return
+		}
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               iamkubellmio.UserConditionAuthLimitExceeded,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: user.Generation,
+			LastTransitionTime: metav1.Time{Time: failureTime},
+			Reason:             ReasonTooManyFailedLogins,
+			Message:            fmt.Sprintf("Login is temporarily blocked after %d failed login attempts", attempts),
+		})
+		locked = true
+	})
+	return locked, err
+}
@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+var (
+	// ErrPasswordRequired 表示自注册时没有设置密码
+	ErrPasswordRequired = errors.New("a password is required")
+	// ErrVerificationStale 表示发送验证邮件后用户被重建或修改了电子邮件地址，验证链接不再有效
+	ErrVerificationStale = errors.New("the user has changed since the verification email was sent")
+	// ErrNotPendingApproval 表示用户不在等待审批，例如已经被管理员拒绝
+	ErrNotPendingApproval = errors.New("the user is not waiting for approval")
+)
+
+// Registration 供自注册使用，创建的用户带有值为 False 的 Approved 条件，等待管理员审批或验证电子邮件后自动审批
+type Registration struct {
+	store  *genericregistry.Store
+	status *StatusREST
+}
+
+// Register 创建自注册的用户，用户处于 PendingApproval 状态，需要管理员通过 approve 子资源审批。
+// 注册时填写的电子邮件地址没有经过验证，因此不在注册时根据它自动审批，而是在用户验证电子邮件后由 AutoApprove 审批。
+// Spec 按普通创建请求校验，密码必须设置并满足密码策略。
+func (r *Registration) Register(ctx context.Context, user *iamkubellmio.User, now time.Time) (*iamkubellmio.User, error) {
+	if len(user.Spec.Password) == 0 {
+		return nil, ErrPasswordRequired
+	}
+
+	condition := metav1.Condition{
+		Type:               iamkubellmio.UserConditionApproved,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: 1,
+		LastTransitionTime: metav1.Time{Time: now},
+		Reason:             iamkubellmio.UserReasonPendingApproval,
+		Message:            "Waiting for an administrator to approve the registration",
+	}
+	user.Status = iamkubellmio.UserStatus{
+		State:              iamkubellmio.UserPendingApproval,
+		Reason:             condition.Reason,
+		Message:            condition.Message,
+		LastTransitionTime: &metav1.Time{Time: now},
+		Conditions:         []metav1.Condition{condition},
+	}
+
+	obj, err := r.store.Create(genericapirequest.WithNamespace(ctx, metav1.NamespaceNone), user, rest.ValidateAllObjectFunc, &metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*iamkubellmio.User), nil
+}
+
+// AutoApprove 在用户验证了电子邮件地址后通过审批，uid 与 email 是发送验证邮件时用户的 UID 与电子邮件地址。
+// 电子邮件域名是否在白名单中由调用方判断。已经通过审批的用户不会被修改；
+// 被拒绝的用户返回 ErrNotPendingApproval，被重建或修改了电子邮件地址的用户返回 ErrVerificationStale。
+func (r *Registration) AutoApprove(ctx context.Context, name string, uid types.UID, email string, now time.Time) (*iamkubellmio.User, error) {
+	return r.status.mutate(ctx, name, func(user *iamkubellmio.User) error {
+		if user.UID != uid || user.Spec.Email != email {
+			return ErrVerificationStale
+		}
+		current := apimeta.FindStatusCondition(user.Status.Conditions, iamkubellmio.UserConditionApproved)
+		if current != nil && current.Status == metav1.ConditionTrue {
+			return nil
+		}
+		if current == nil || current.Reason != iamkubellmio.UserReasonPendingApproval {
+			return ErrNotPendingApproval
+		}
+		condition := metav1.Condition{
+			Type:               iamkubellmio.UserConditionApproved,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: user.Generation,
+			LastTransitionTime: metav1.Time{Time: now},
+			Reason:             iamkubellmio.UserReasonAutoApproved,
+			Message:            "The email address is verified and its domain is allowed to register without approval",
+		}
+		apimeta.SetStatusCondition(&user.Status.Conditions, condition)
+		if user.Status.State == iamkubellmio.UserPendingApproval {
+			setApprovalState(user, condition)
+		}
+		return nil
+	})
+}
+
+// registrationStrategy 在创建时保留 Register 设置的审批状态，其余与普通创建相同
+type registrationStrategy struct {
+	userStrategy
+}
+
+// PrepareForCreate 清空 Status 后恢复审批相关的字段，只有 Registration 使用该策略，客户端无法设置 Status
+func (s registrationStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	user := obj.(*iamkubellmio.User)
+	approval := user.Status
+	s.userStrategy.PrepareForCreate(ctx, obj)
+	user.Status.State = approval.State
+	user.Status.Reason = approval.Reason
+	user.Status.Message = approval.Message
+	user.Status.LastTransitionTime = approval.LastTransitionTime
+	user.Status.Conditions = approval.Conditions
+}
+
+// newRegistrationStore 基于 User 的存储创建自注册使用的存储
+func newRegistrationStore(store *genericregistry.Store, strategy userStrategy) *genericregistry.Store {
+	registrationStore := *store
+	registrationStore.CreateStrategy = registrationStrategy{strategy}
+	return &registrationStore
+}
@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+)
+
+// REST 实现了 User 资源的 RESTStorage，处理标准的增删改查与 Watch 请求
+type REST struct {
+	*genericregistry.Store
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+var _ rest.CategoriesProvider = &REST{}
+
+// ShortNames 返回 User 资源的短名称，与 CRD 中的 shortName 保持一致
+func (r *REST) ShortNames() []string {
+	return []string{"usr"}
+}
+
+// Categories 返回 User 所属的资源分类，与 CRD 中的 categories 保持一致
+func (r *REST) Categories() []string {
+	return []string{"iam"}
+}
+
+// Watch 在 Store.Watch 的基础上去掉事件中的密码哈希与密码历史。
+// 启用 watch cache 时事件中的对象是所有 watcher 共享的 runtime.CacheableObject，Store 的 Decorator 无法修改其中的 User，
+// 因此取出它的深拷贝后再去掉密码，同时不会修改缓存中的对象。
+func (r *REST) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	w, err := r.Store.Watch(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, stripPasswordEvent), nil
+}
+
+// stripPasswordEvent 去掉 Watch 事件中 User 的密码哈希与密码历史
+func stripPasswordEvent(event watch.Event) (watch.Event, bool) {
+	if cacheable, ok := event.Object.(runtime.CacheableObject); ok {
+		event.Object = cacheable.GetObject()
+	}
+	stripPassword(event.Object)
+	return event, true
+}
+
+// StatusREST 实现了 User 的 status 子资源
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var _ rest.Patcher = &StatusREST{}
+
+// New 创建一个新的 User 对象
+func (r *StatusREST) New() runtime.Object {
+	return &iamkubellmio.User{}
+}
+
+// Destroy 在关闭时清理资源，底层存储由主资源负责释放
+func (r *StatusREST) Destroy() {
+}
+
+// Get 获取 User 对象，供 patch 使用
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update 只修改 User 的 Status
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc,
+	updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// 子资源不允许通过更新创建对象
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// GetResetFields 实现 rest.ResetFieldsStrategy
+func (r *StatusREST) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return r.store.GetResetFields()
+}
+
+// mutate 在存储中的最新对象上执行 mutate 并写回 Status，mutate 返回错误时放弃修改。
+// 写入基于 etcd 的比较并交换，与其他副本的并发修改冲突时会重新读取并再次执行 mutate，因此 mutate 只能基于传入的对象修改。
+func (r *StatusREST) mutate(ctx context.Context, name string, mutate func(user *iamkubellmio.User) error) (*iamkubellmio.User, error) {
+	// 没有指定新对象，在存储中的最新对象上修改
+	transformer := func(_ context.Context, _, old runtime.Object) (runtime.Object, error) {
+		user := old.(*iamkubellmio.User).DeepCopy()
+		if err := mutate(user); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	obj, _, err := r.Update(genericapirequest.WithNamespace(ctx, metav1.NamespaceNone), name, rest.DefaultUpdatedObjectInfo(nil, transformer),
+		rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*iamkubellmio.User), nil
+}
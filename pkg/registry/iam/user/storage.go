@@ -0,0 +1,80 @@
+package user
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/utils/clock"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/auth/password"
+)
+
+// UserStorage 聚合了 User 主资源及其子资源的存储
+type UserStorage struct {
+	User   *REST
+	Status *StatusREST
+	// Approve 与 Reject 是审批自注册用户的子资源
+	Approve *ApprovalREST
+	Reject  *ApprovalREST
+	// Credentials 供登录使用，读取结果包含密码哈希
+	Credentials *Credentials
+	// Registration 供自注册使用
+	Registration *Registration
+}
+
+// NewStorage 创建基于 etcd 的 User 存储，passwordPolicy 用于校验创建与更新时设置的密码
+func NewStorage(scheme *runtime.Scheme, optsGetter generic.RESTOptionsGetter, passwordPolicy *password.Policy) (*UserStorage, error) {
+	strategy := NewStrategy(scheme, passwordPolicy)
+
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &iamkubellmio.User{} },
+		NewListFunc:               func() runtime.Object { return &iamkubellmio.UserList{} },
+		PredicateFunc:             MatchUser,
+		DefaultQualifiedResource:  iamkubellmio.Resource("users"),
+		SingularQualifiedResource: iamkubellmio.Resource("user"),
+
+		CreateStrategy:      strategy,
+		UpdateStrategy:      strategy,
+		DeleteStrategy:      strategy,
+		ResetFieldsStrategy: strategy,
+
+		// 明文密码在校验之前按密码策略检查并哈希，哈希失败时请求失败
+		BeginCreate: strategy.beginCreate,
+		BeginUpdate: strategy.beginUpdate,
+
+		// 所有返回给客户端的对象（GET/LIST 以及写操作的响应）都会去掉密码哈希，
+		// WATCH 事件中的对象可能来自 watch cache，由 REST.Watch 处理
+		Decorator: stripPassword,
+
+		TableConvertor: NewTableConvertor(),
+	}
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return nil, err
+	}
+
+	statusStrategy := NewStatusStrategy(strategy)
+	statusStore := *store
+	statusStore.UpdateStrategy = statusStrategy
+	statusStore.ResetFieldsStrategy = statusStrategy
+	// status 子资源不修改 Spec，也就不会修改密码
+	statusStore.BeginUpdate = nil
+
+	credentialsStore := *store
+	credentialsStore.Decorator = nil
+	// 登录逻辑通过它记录登录信息与刷新令牌会话，写入的结果不返回给客户端
+	credentialsStatusStore := statusStore
+	credentialsStatusStore.UpdateStrategy = credentialsStatusStrategy{statusStrategy}
+	credentialsStatusStore.Decorator = nil
+
+	statusREST := &StatusREST{store: &statusStore}
+	return &UserStorage{
+		User:         &REST{Store: store},
+		Status:       statusREST,
+		Approve:      &ApprovalREST{status: statusREST, approve: true, clock: clock.RealClock{}},
+		Reject:       &ApprovalREST{status: statusREST, approve: false, clock: clock.RealClock{}},
+		Credentials:  &Credentials{store: &credentialsStore, status: &StatusREST{store: &credentialsStatusStore}},
+		Registration: &Registration{store: newRegistrationStore(store, strategy), status: statusREST},
+	}, nil
+}
@@ -0,0 +1,319 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	etcd3testing "k8s.io/apiserver/pkg/storage/etcd3/testing"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/install"
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+	"github.com/kubellm-io/kubellm/pkg/auth/password"
+)
+
+// newCachedStorage 创建基于测试 etcd 并启用 watch cache 的 User 存储
+func newCachedStorage(t *testing.T) *UserStorage {
+	t.Helper()
+	server, config := etcd3testing.NewUnsecuredEtcd3TestClientServer(t)
+	defer server.Terminate(t)
+
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	config.Codec = serializer.NewCodecFactory(scheme).LegacyCodec(iamv1alpha1.SchemeGroupVersion)
+	config.EventsHistoryWindow = storagebackend.DefaultEventsHistoryWindow
+	restOptions := generic.RESTOptions{
+		StorageConfig:           config.ForResource(iamkubellmio.Resource("users")),
+		Decorator:               genericregistry.StorageWithCacher(),
+		DeleteCollectionWorkers: 1,
+		ResourcePrefix:          "users",
+	}
+
+	policy := password.DefaultPolicy()
+	policy.HistoryDepth = 3
+	storage, err := NewStorage(scheme, restOptions, policy)
+	if err != nil {
+		t.Fatalf("failed to create user storage: %v", err)
+	}
+	t.Cleanup(storage.User.Store.DestroyFunc)
+	return storage
+}
+
+// TestWatchStripsPassword 确认启用 watch cache 时 WATCH 事件中不包含密码哈希与密码历史
+func TestWatchStripsPassword(t *testing.T) {
+	storage := newCachedStorage(t)
+	ctx := genericapirequest.WithNamespace(context.Background(), metav1.NamespaceNone)
+
+	user := &iamkubellmio.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+		Spec:       iamkubellmio.UserSpec{Email: "alice@example.com", Password: "first-password"},
+	}
+	if _, err := storage.User.Create(ctx, user, rest.ValidateAllObjectFunc, &metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	// ResourceVersion 为 0 时从 watch cache 读取，初始事件与后续事件都经过缓存
+	w, err := storage.User.Watch(ctx, &metainternalversion.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		t.Fatalf("failed to watch users: %v", err)
+	}
+	defer w.Stop()
+
+	changed, _, err := storage.User.Update(ctx, "alice", rest.DefaultUpdatedObjectInfo(nil, func(_ context.Context, _, old runtime.Object) (runtime.Object, error) {
+		user := old.(*iamkubellmio.User).DeepCopy()
+		user.Spec.Password = "second-password"
+		return user, nil
+	}), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("failed to change password: %v", err)
+	}
+
+	// 确认存储中确实保存了哈希与历史，否则下面的检查没有意义
+	stored, err := storage.Credentials.Get(ctx, "alice")
+	if err != nil {
+		t.Fatalf("failed to get stored user: %v", err)
+	}
+	if len(stored.Spec.Password) == 0 || len(stored.Status.PasswordHistory) == 0 {
+		t.Fatalf("expected the stored user to have a password hash and history, got %+v", stored.Spec)
+	}
+
+	expected := []watch.EventType{watch.Added, watch.Modified}
+	for _, eventType := range expected {
+		select {
+		case event := <-w.ResultChan():
+			if event.Type != eventType {
+				t.Fatalf("expected %s event, got %s: %v", eventType, event.Type, event.Object)
+			}
+			got, ok := event.Object.(*iamkubellmio.User)
+			if !ok {
+				t.Fatalf("expected a User in the %s event, got %T", event.Type, event.Object)
+			}
+			if len(got.Spec.Password) > 0 || len(got.Status.PasswordHistory) > 0 {
+				t.Errorf("%s event exposes the password: password %q, history %v", event.Type, got.Spec.Password, got.Status.PasswordHistory)
+			}
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("timed out waiting for %s event", eventType)
+		}
+	}
+
+	// 去掉密码时不能修改缓存中共享的对象
+	if got := changed.(*iamkubellmio.User); len(got.Spec.Password) > 0 {
+		t.Errorf("update response exposes the password hash")
+	}
+	stored, err = storage.Credentials.Get(ctx, "alice")
+	if err != nil {
+		t.Fatalf("failed to get stored user: %v", err)
+	}
+	if len(stored.Spec.Password) == 0 {
+		t.Errorf("stripping watch events removed the password hash from storage")
+	}
+}
+
+// fakeResponder 记录子资源返回的对象或错误
+type fakeResponder struct {
+	obj runtime.Object
+	err error
+}
+
+func (r *fakeResponder) Object(_ int, obj runtime.Object) { r.obj = obj }
+func (r *fakeResponder) Error(err error)                  { r.err = err }
+
+// TestInitialState 确认创建与审批时设置初始的 State，用户不会以空的 State 登录
+func TestInitialState(t *testing.T) {
+	storage := newCachedStorage(t)
+	ctx := genericapirequest.WithNamespace(context.Background(), metav1.NamespaceNone)
+
+	created, err := storage.User.Create(ctx, &iamkubellmio.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin-created"},
+		Spec:       iamkubellmio.UserSpec{Email: "admin-created@example.com", Password: "first-password"},
+		// 客户端不能设置 Status
+		Status: iamkubellmio.UserStatus{State: iamkubellmio.UserLocked},
+	}, rest.ValidateAllObjectFunc, &metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	if state := created.(*iamkubellmio.User).Status.State; state != iamkubellmio.UserActive {
+		t.Errorf("expected user created by an administrator to be Active, got %q", state)
+	}
+
+	approve := func(name string, approve bool) *iamkubellmio.User {
+		t.Helper()
+		registered, err := storage.Registration.Register(ctx, &iamkubellmio.User{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       iamkubellmio.UserSpec{Email: name + "@example.com", Password: "first-password"},
+		}, time.Now())
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		if registered.Status.State != iamkubellmio.UserPendingApproval {
+			t.Errorf("expected registered user to be PendingApproval, got %q", registered.Status.State)
+		}
+
+		subresource := storage.Approve
+		if !approve {
+			subresource = storage.Reject
+		}
+		responder := &fakeResponder{}
+		handler, err := subresource.Connect(ctx, name, &iamkubellmio.UserApprovalOptions{}, responder)
+		if err != nil {
+			t.Fatalf("failed to connect approval subresource: %v", err)
+		}
+		handler.ServeHTTP(nil, nil)
+		if responder.err != nil {
+			t.Fatalf("failed to approve user: %v", responder.err)
+		}
+		return responder.obj.(*iamkubellmio.User)
+	}
+
+	if state := approve("approved", true).Status.State; state != iamkubellmio.UserActive {
+		t.Errorf("expected approved user to be Active, got %q", state)
+	}
+	if state := approve("rejected", false).Status.State; state != iamkubellmio.UserDisabled {
+		t.Errorf("expected rejected user to be Disabled, got %q", state)
+	}
+}
+
+// TestRefreshTokenRotation 确认刷新令牌只能使用一次，已经使用过的刷新令牌再次出现时撤销整个会话
+func TestRefreshTokenRotation(t *testing.T) {
+	storage := newCachedStorage(t)
+	ctx := genericapirequest.WithNamespace(context.Background(), metav1.NamespaceNone)
+	if _, err := storage.User.Create(ctx, &iamkubellmio.User{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+		Spec:       iamkubellmio.UserSpec{Email: "alice@example.com", Password: "first-password"},
+	}, rest.ValidateAllObjectFunc, &metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	now := time.Now()
+	session := func(id, tokenID string) iamkubellmio.RefreshTokenSession {
+		return iamkubellmio.RefreshTokenSession{ID: id, TokenID: tokenID, ExpirationTime: metav1.NewTime(now.Add(time.Hour))}
+	}
+	credentials := storage.Credentials
+	for _, id := range []string{"laptop", "phone"} {
+		if err := credentials.RecordLogin(ctx, "alice", "203.0.113.7", now, session(id, id+"-1")); err != nil {
+			t.Fatalf("failed to record login: %v", err)
+		}
+	}
+
+	if err := credentials.RotateRefreshToken(ctx, "alice", "laptop-1", session("laptop", "laptop-2"), now); err != nil {
+		t.Fatalf("failed to rotate refresh token: %v", err)
+	}
+	// 重放已经使用过的刷新令牌撤销整个会话，之后最新的刷新令牌也无法使用
+	if err := credentials.RotateRefreshToken(ctx, "alice", "laptop-1", session("laptop", "laptop-3"), now); !errors.Is(err, ErrRefreshTokenRevoked) {
+		t.Fatalf("expected reused refresh token to be rejected, got %v", err)
+	}
+	if err := credentials.RotateRefreshToken(ctx, "alice", "laptop-2", session("laptop", "laptop-3"), now); !errors.Is(err, ErrRefreshTokenRevoked) {
+		t.Fatalf("expected revoked session to be rejected, got %v", err)
+	}
+	// 其他会话不受影响
+	if err := credentials.RotateRefreshToken(ctx, "alice", "phone-1", session("phone", "phone-2"), now); err != nil {
+		t.Fatalf("failed to rotate refresh token of another session: %v", err)
+	}
+
+	// 会话不返回给客户端，也不能通过 status 子资源修改
+	obj, err := storage.User.Get(ctx, "alice", &metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get user: %v", err)
+	}
+	user := obj.(*iamkubellmio.User)
+	if len(user.Status.RefreshTokenSessions) > 0 {
+		t.Errorf("expected refresh token sessions to be hidden, got %v", user.Status.RefreshTokenSessions)
+	}
+	user.Status.RefreshTokenSessions = []iamkubellmio.RefreshTokenSession{session("forged", "forged-1")}
+	if _, _, err := storage.Status.Update(ctx, "alice", rest.DefaultUpdatedObjectInfo(user), rest.ValidateAllObjectFunc,
+		rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("failed to update status: %v", err)
+	}
+	stored, err := credentials.Get(ctx, "alice")
+	if err != nil {
+		t.Fatalf("failed to get stored user: %v", err)
+	}
+	if sessions := stored.Status.RefreshTokenSessions; len(sessions) != 1 || sessions[0].ID != "phone" || sessions[0].TokenID != "phone-2" {
+		t.Errorf("expected only the phone session to be left, got %v", sessions)
+	}
+
+	// 修改密码后撤销所有会话
+	if _, _, err := storage.User.Update(ctx, "alice", rest.DefaultUpdatedObjectInfo(nil, func(_ context.Context, _, old runtime.Object) (runtime.Object, error) {
+		user := old.(*iamkubellmio.User).DeepCopy()
+		user.Spec.Password = "second-password"
+		return user, nil
+	}), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("failed to change password: %v", err)
+	}
+	if err := credentials.RotateRefreshToken(ctx, "alice", "phone-2", session("phone", "phone-3"), now); !errors.Is(err, ErrRefreshTokenRevoked) {
+		t.Errorf("expected sessions to be revoked after the password changed, got %v", err)
+	}
+}
+
+// TestAutoApprove 确认验证了电子邮件地址的用户只有在仍等待审批、且没有被重建或修改电子邮件地址时才自动通过审批
+func TestAutoApprove(t *testing.T) {
+	storage := newCachedStorage(t)
+	ctx := genericapirequest.WithNamespace(context.Background(), metav1.NamespaceNone)
+	register := func(name string) *iamkubellmio.User {
+		t.Helper()
+		registered, err := storage.Registration.Register(ctx, &iamkubellmio.User{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       iamkubellmio.UserSpec{Email: name + "@example.com", Password: "first-password"},
+		}, time.Now())
+		if err != nil {
+			t.Fatalf("failed to register user: %v", err)
+		}
+		return registered
+	}
+
+	alice := register("alice")
+	if _, err := storage.Registration.AutoApprove(ctx, alice.Name, "other-uid", alice.Spec.Email, time.Now()); !errors.Is(err, ErrVerificationStale) {
+		t.Errorf("expected a token for a recreated user to be stale, got %v", err)
+	}
+	if _, err := storage.Registration.AutoApprove(ctx, alice.Name, alice.UID, "mallory@example.com", time.Now()); !errors.Is(err, ErrVerificationStale) {
+		t.Errorf("expected a token for another email address to be stale, got %v", err)
+	}
+	approved, err := storage.Registration.AutoApprove(ctx, alice.Name, alice.UID, alice.Spec.Email, time.Now())
+	if err != nil {
+		t.Fatalf("failed to approve user: %v", err)
+	}
+	if approved.Status.State != iamkubellmio.UserActive {
+		t.Errorf("expected verified user to be Active, got %q", approved.Status.State)
+	}
+	condition := apimeta.FindStatusCondition(approved.Status.Conditions, iamkubellmio.UserConditionApproved)
+	if condition == nil || condition.Status != metav1.ConditionTrue || condition.Reason != iamkubellmio.UserReasonAutoApproved {
+		t.Errorf("expected an AutoApproved condition, got %+v", condition)
+	}
+	// 重复打开验证链接不修改用户
+	again, err := storage.Registration.AutoApprove(ctx, alice.Name, alice.UID, alice.Spec.Email, time.Now())
+	if err != nil {
+		t.Fatalf("failed to verify email again: %v", err)
+	}
+	if again.ResourceVersion != approved.ResourceVersion {
+		t.Errorf("expected repeated verification not to update the user")
+	}
+
+	// 管理员已经拒绝的用户不能通过验证电子邮件绕过拒绝
+	bob := register("bob")
+	responder := &fakeResponder{}
+	handler, err := storage.Reject.Connect(ctx, bob.Name, &iamkubellmio.UserApprovalOptions{}, responder)
+	if err != nil {
+		t.Fatalf("failed to connect reject subresource: %v", err)
+	}
+	handler.ServeHTTP(nil, nil)
+	if responder.err != nil {
+		t.Fatalf("failed to reject user: %v", responder.err)
+	}
+	if _, err := storage.Registration.AutoApprove(ctx, bob.Name, bob.UID, bob.Spec.Email, time.Now()); !errors.Is(err, ErrNotPendingApproval) {
+		t.Errorf("expected a rejected user not to be approved, got %v", err)
+	}
+}
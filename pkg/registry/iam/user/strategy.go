@@ -0,0 +1,215 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/auth/password"
+)
+
+// userStrategy 实现了 User 资源在创建、更新、删除时的业务逻辑，
+// 例如默认值填充、字段重置以及校验。
+type userStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	// passwordPolicy 是创建与更新时校验密码使用的策略
+	passwordPolicy *password.Policy
+}
+
+// NewStrategy 创建 User 资源的存储策略
+func NewStrategy(typer runtime.ObjectTyper, passwordPolicy *password.Policy) userStrategy {
+	return userStrategy{typer, names.SimpleNameGenerator, passwordPolicy}
+}
+
+// GetAttrs 返回 User 对象的标签与可用于字段选择器的字段集合
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	user, ok := obj.(*iamkubellmio.User)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a User")
+	}
+	return labels.Set(user.Labels), SelectableFields(user), nil
+}
+
+// MatchUser 返回 etcd 存储在 List/Watch 时用于按标签和字段过滤 User 的谓词
+func MatchUser(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+// SelectableFields 返回 User 可用于字段选择器的字段集合
+func SelectableFields(user *iamkubellmio.User) fields.Set {
+	return generic.ObjectMetaFieldsSet(&user.ObjectMeta, false)
+}
+
+// NamespaceScoped User 为集群级资源
+func (userStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// GetResetFields 返回主资源更新时会被重置的字段，Status 只能通过 status 子资源修改
+func (userStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"iam.kubellm.io/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("status"),
+		),
+	}
+}
+
+// PrepareForCreate 创建前清空 Status，状态只能由控制器通过 status 子资源写入。
+// 管理员创建的用户视为已通过审批，初始状态为 Active，之后由控制器根据条件推导。
+// 设置了密码时记录密码的修改时间，控制器据此计算密码的过期时间。
+func (userStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	user := obj.(*iamkubellmio.User)
+	now := metav1.Now()
+	user.Status = iamkubellmio.UserStatus{
+		State:              iamkubellmio.UserActive,
+		LastTransitionTime: &now,
+	}
+	if user.Spec.Password != "" {
+		user.Status.PasswordLastChangedTime = &now
+	}
+	user.Generation = 1
+}
+
+// PrepareForUpdate 更新主资源时保留旧的 Status。
+// 由于读取接口不会返回密码哈希，客户端回写的对象中密码通常为空，此时沿用旧的哈希，
+// 避免一次普通的更新把密码清空。密码被修改时记录修改时间，并把旧密码的哈希加入历史记录。
+func (s userStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newUser := obj.(*iamkubellmio.User)
+	oldUser := old.(*iamkubellmio.User)
+	newUser.Status = oldUser.Status
+
+	if newUser.Spec.Password == "" {
+		newUser.Spec.Password = oldUser.Spec.Password
+	}
+	if newUser.Spec.Password != oldUser.Spec.Password {
+		newUser.Status.PasswordLastChangedTime = &metav1.Time{Time: time.Now()}
+		newUser.Status.PasswordHistory = passwordHistory(s.passwordPolicy, oldUser.Spec.Password, oldUser.Status.PasswordHistory)
+		// 修改密码后撤销所有刷新令牌会话
+		newUser.Status.RefreshTokenSessions = nil
+	}
+
+	if !apiequality.Semantic.DeepEqual(newUser.Spec, oldUser.Spec) {
+		newUser.Generation = oldUser.Generation + 1
+	}
+}
+
+// Validate 校验新创建的 User，密码已经在 BeginCreate 中按密码策略校验并哈希
+func (userStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	user := obj.(*iamkubellmio.User)
+	return ValidateUser(user)
+}
+
+// WarningsOnCreate 返回创建 User 时的告警信息
+func (userStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// AllowCreateOnUpdate 不允许通过 Update 创建 User
+func (userStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate 允许不带 resourceVersion 的更新
+func (userStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// Canonicalize 规范化对象，密码已经在 BeginCreate 与 BeginUpdate 中哈希，User 无需处理
+func (userStrategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate 校验 User 的更新，新密码已经在 BeginUpdate 中按密码策略与历史记录校验并哈希
+func (userStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateUserUpdate(obj.(*iamkubellmio.User), old.(*iamkubellmio.User))
+}
+
+// WarningsOnUpdate 返回更新 User 时的告警信息
+func (userStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// userStatusStrategy 是 status 子资源的更新策略，只允许修改 Status
+type userStatusStrategy struct {
+	userStrategy
+}
+
+// NewStatusStrategy 创建 User status 子资源的更新策略
+func NewStatusStrategy(strategy userStrategy) userStatusStrategy {
+	return userStatusStrategy{strategy}
+}
+
+// GetResetFields 返回 status 子资源更新时会被重置的字段
+func (userStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"iam.kubellm.io/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+// PrepareForUpdate 通过 status 子资源更新时丢弃对 Spec 的修改。
+// 密码历史与刷新令牌会话只由 kubellm-apiserver 维护，且读取时不会返回，因此总是保留旧值。
+func (userStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newUser := obj.(*iamkubellmio.User)
+	oldUser := old.(*iamkubellmio.User)
+	newUser.Spec = oldUser.Spec
+	newUser.Status.PasswordHistory = oldUser.Status.PasswordHistory
+	newUser.Status.RefreshTokenSessions = oldUser.Status.RefreshTokenSessions
+}
+
+// credentialsStatusStrategy 是登录逻辑记录登录信息时的更新策略，与 status 子资源相同，但允许修改刷新令牌会话
+type credentialsStatusStrategy struct {
+	userStatusStrategy
+}
+
+// PrepareForUpdate 丢弃对 Spec 的修改并保留密码历史
+func (credentialsStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newUser := obj.(*iamkubellmio.User)
+	oldUser := old.(*iamkubellmio.User)
+	newUser.Spec = oldUser.Spec
+	newUser.Status.PasswordHistory = oldUser.Status.PasswordHistory
+}
+
+// ValidateUpdate 校验 status 子资源的更新
+func (userStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateUserUpdate(obj.(*iamkubellmio.User), old.(*iamkubellmio.User))
+}
+
+// WarningsOnUpdate 返回更新 status 子资源时的告警信息
+func (userStatusStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// stripPassword 从返回给客户端的对象中移除密码哈希、密码历史与刷新令牌会话，保证这些字段只写不读
+func stripPassword(obj runtime.Object) {
+	switch t := obj.(type) {
+	case *iamkubellmio.User:
+		t.Spec.Password = ""
+		t.Status.PasswordHistory = nil
+		t.Status.RefreshTokenSessions = nil
+	case *iamkubellmio.UserList:
+		for i := range t.Items {
+			t.Items[i].Spec.Password = ""
+			t.Items[i].Status.PasswordHistory = nil
+			t.Items[i].Status.RefreshTokenSessions = nil
+		}
+	}
+}
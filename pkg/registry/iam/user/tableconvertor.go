@@ -0,0 +1,41 @@
+package user
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	"github.com/kubellm-io/kubellm/pkg/registry/table"
+)
+
+// userColumns 与 User 类型上的 +kubebuilder:printcolumn 标记保持一致
+var userColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Format: "name", Description: table.NameDescription},
+	{Name: "DisplayName", Type: "string", Description: "用户的显示名称"},
+	{Name: "Email", Type: "string", Description: "用户的电子邮件地址"},
+	{Name: "Status", Type: "string", Description: "用户的当前状态"},
+	{Name: "LastLoginTime", Type: "date", Description: "用户最后登录时间"},
+	{Name: "Age", Type: "date", Description: table.AgeDescription},
+}
+
+// NewTableConvertor 创建 User 的 TableConvertor
+func NewTableConvertor() *table.Convertor {
+	return table.NewConvertor(userColumns, userRow)
+}
+
+func userRow(obj runtime.Object, name, age string) ([]interface{}, error) {
+	user, ok := obj.(*iamkubellmio.User)
+	if !ok {
+		return nil, fmt.Errorf("given object is not a User")
+	}
+	return []interface{}{
+		name,
+		table.StringCell(user.Spec.DisplayName),
+		table.StringCell(user.Spec.Email),
+		table.StringCell(string(user.Status.State)),
+		table.TimeCell(user.Status.LastLoginTime),
+		age,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package user
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	iamkubellmio "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io"
+	iamv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/iam.kubellm.io/v1alpha1"
+)
+
+var phoneNumberRegexp = regexp.MustCompile(`^\+?[0-9\s\-\(\)]*$`)
+
+// ValidateUser 校验 User 对象
+func ValidateUser(user *iamkubellmio.User) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&user.ObjectMeta, false, path.ValidatePathSegmentName, field.NewPath("metadata"))
+	if strings.HasPrefix(user.Name, iamv1alpha1.SystemPrefix) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), user.Name, "must not start with '"+iamv1alpha1.SystemPrefix+"'"))
+	}
+	allErrs = append(allErrs, ValidateUserSpec(&user.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateUserUpdate 校验 User 的更新
+func ValidateUserUpdate(newUser, oldUser *iamkubellmio.User) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&newUser.ObjectMeta, &oldUser.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateUserSpec(&newUser.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateUserSpec 校验 UserSpec 中各字段的格式与长度，密码由存储策略按密码策略单独校验
+func ValidateUserSpec(spec *iamkubellmio.UserSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(spec.Email) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("email"), ""))
+	} else if _, err := mail.ParseAddress(spec.Email); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("email"), spec.Email, "must be a valid email address"))
+	}
+	allErrs = append(allErrs, validateMaxLength(spec.Email, 254, fldPath.Child("email"))...)
+
+	allErrs = append(allErrs, validateMaxLength(spec.DisplayName, 128, fldPath.Child("displayName"))...)
+	allErrs = append(allErrs, validateMaxLength(spec.Lang, 32, fldPath.Child("lang"))...)
+	allErrs = append(allErrs, validateMaxLength(spec.Description, 1024, fldPath.Child("description"))...)
+	allErrs = append(allErrs, validateMaxLength(spec.PhoneNumber, 32, fldPath.Child("phoneNumber"))...)
+	if !phoneNumberRegexp.MatchString(spec.PhoneNumber) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("phoneNumber"), spec.PhoneNumber, "must contain only digits, spaces, '-', '(', ')' and an optional leading '+'"))
+	}
+	allErrs = append(allErrs, validateMaxLength(spec.Avatar, 2048, fldPath.Child("avatar"))...)
+	allErrs = append(allErrs, validateMaxLength(spec.Department, 256, fldPath.Child("department"))...)
+	allErrs = append(allErrs, validateMaxLength(spec.Position, 128, fldPath.Child("position"))...)
+	allErrs = append(allErrs, validateMaxLength(spec.ExternalID, 256, fldPath.Child("externalID"))...)
+	allErrs = append(allErrs, validateMaxLength(spec.IdentityProvider, 64, fldPath.Child("identityProvider"))...)
+
+	groups := make(map[string]struct{}, len(spec.Groups))
+	for i, group := range spec.Groups {
+		if len(group) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("groups").Index(i), ""))
+			continue
+		}
+		if strings.HasPrefix(group, iamv1alpha1.SystemPrefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("groups").Index(i), group, "must not start with '"+iamv1alpha1.SystemPrefix+"'"))
+		}
+		if _, ok := groups[group]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("groups").Index(i), group))
+		}
+		groups[group] = struct{}{}
+	}
+
+	return allErrs
+}
+
+func validateMaxLength(value string, maxLength int, fldPath *field.Path) field.ErrorList {
+	if len(value) > maxLength {
+		return field.ErrorList{field.TooLong(fldPath, "", maxLength)}
+	}
+	return nil
+}
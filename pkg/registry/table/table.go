@@ -0,0 +1,73 @@
+package table
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// 名称列与创建时间列的描述，与 CRD 的默认列一致
+var (
+	NameDescription = metav1.ObjectMeta{}.SwaggerDoc()["name"]
+	AgeDescription  = metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]
+)
+
+// RowFunc 返回对象的一行单元格，name 与 age 分别是格式化后的名称与存在时间
+type RowFunc func(obj runtime.Object, name, age string) ([]interface{}, error)
+
+// Convertor 按照固定的列定义把对象或对象列表转换为 Table，供 kubectl get 展示。
+// 列定义应与对应 CRD 的 additionalPrinterColumns 保持一致，使聚合 API 与 CRD 的展示效果相同。
+type Convertor struct {
+	columns []metav1.TableColumnDefinition
+	rowFunc RowFunc
+}
+
+var _ rest.TableConvertor = &Convertor{}
+
+// NewConvertor 创建 Convertor，rowFunc 返回的单元格需要与 columns 一一对应
+func NewConvertor(columns []metav1.TableColumnDefinition, rowFunc RowFunc) *Convertor {
+	return &Convertor{columns: columns, rowFunc: rowFunc}
+}
+
+// ConvertToTable 把对象或对象列表转换为 Table
+func (c *Convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{}
+	opt, ok := tableOptions.(*metav1.TableOptions)
+	if !ok || opt == nil || !opt.NoHeaders {
+		table.ColumnDefinitions = c.columns
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+		table.RemainingItemCount = m.GetRemainingItemCount()
+	} else if m, err := meta.CommonAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+	}
+
+	var err error
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, name, age string) ([]interface{}, error) {
+		return c.rowFunc(obj, name, age)
+	})
+	return table, err
+}
+
+// StringCell 返回字符串单元格，空字符串与 CRD 中缺失的字段一样显示为 <none>
+func StringCell(s string) interface{} {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
+// TimeCell 返回时间单元格，格式与 CRD 中 date 类型的列一致
+func TimeCell(t *metav1.Time) interface{} {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return metatable.ConvertToHumanReadableDateType(*t)
+}
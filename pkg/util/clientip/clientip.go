@@ -0,0 +1,44 @@
+package clientip
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	netutils "k8s.io/utils/net"
+)
+
+// SourceIP 返回请求的客户端 IP。默认使用连接的对端地址，因为请求头可以由客户端任意设置；
+// 只有对端属于 trustedProxies 时才读取 X-Forwarded-For，并从右向左跳过受信任的代理，取第一个不受信任的地址。
+// 代理只在 X-Forwarded-For 末尾追加地址，更靠左的部分可能由客户端伪造。
+func SourceIP(req *http.Request, trustedProxies []*net.IPNet) net.IP {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	ip := netutils.ParseIPSloppy(host)
+	if ip == nil || !trusted(ip, trustedProxies) {
+		return ip
+	}
+	hops := strings.Split(req.Header.Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := netutils.ParseIPSloppy(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			break
+		}
+		ip = hop
+		if !trusted(ip, trustedProxies) {
+			break
+		}
+	}
+	return ip
+}
+
+func trusted(ip net.IP, trustedProxies []*net.IPNet) bool {
+	for _, cidr := range trustedProxies {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
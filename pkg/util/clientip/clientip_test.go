@@ -0,0 +1,76 @@
+package clientip
+
+import (
+	"net/http"
+	"testing"
+
+	netutils "k8s.io/utils/net"
+)
+
+func TestSourceIP(t *testing.T) {
+	trustedProxies, err := netutils.ParseCIDRs([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatalf("failed to parse CIDRs: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		remoteAddr    string
+		xForwardedFor string
+		expected      string
+	}{
+		{
+			name:       "direct client",
+			remoteAddr: "203.0.113.7:51234",
+			expected:   "203.0.113.7",
+		},
+		{
+			// 不受信任的对端设置的 X-Forwarded-For 被忽略
+			name:          "spoofed header from an untrusted peer",
+			remoteAddr:    "203.0.113.7:51234",
+			xForwardedFor: "198.51.100.1",
+			expected:      "203.0.113.7",
+		},
+		{
+			name:          "trusted proxy",
+			remoteAddr:    "10.0.0.2:443",
+			xForwardedFor: "198.51.100.1",
+			expected:      "198.51.100.1",
+		},
+		{
+			// 只采信受信任的代理追加的地址，更靠左的部分由客户端设置
+			name:          "client prepends a spoofed address",
+			remoteAddr:    "10.0.0.2:443",
+			xForwardedFor: "192.0.2.9, 198.51.100.1, 10.0.0.3",
+			expected:      "198.51.100.1",
+		},
+		{
+			name:       "trusted proxy without header",
+			remoteAddr: "10.0.0.2:443",
+			expected:   "10.0.0.2",
+		},
+		{
+			name:          "invalid hop",
+			remoteAddr:    "10.0.0.2:443",
+			xForwardedFor: "not-an-ip",
+			expected:      "10.0.0.2",
+		},
+		{
+			name:       "remote address without port",
+			remoteAddr: "2001:db8::1",
+			expected:   "2001:db8::1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &http.Request{RemoteAddr: tt.remoteAddr, Header: http.Header{}}
+			if len(tt.xForwardedFor) > 0 {
+				req.Header.Set("X-Forwarded-For", tt.xForwardedFor)
+			}
+			if ip := SourceIP(req, trustedProxies); ip.String() != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, ip)
+			}
+		})
+	}
+}
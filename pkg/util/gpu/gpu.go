@@ -0,0 +1,121 @@
+package gpu
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// GPU feature discovery 以 GPU 扩展资源名称为前缀为节点打标签，
+// 例如 nvidia.com/gpu.product=NVIDIA-A100-SXM4-80GB、nvidia.com/mig-1g.10gb.memory=9728，其中显存的单位为 MiB
+const (
+	productLabelSuffix = ".product"
+	memoryLabelSuffix  = ".memory"
+
+	// migProductSeparator 分隔 single MIG 策略下 nvidia.com/gpu.product 中的产品名与 MIG profile，
+	// 例如 NVIDIA-A100-SXM4-40GB-MIG-1g.5gb
+	migProductSeparator = "-MIG-"
+)
+
+// IsGPUResourceName 判断 name 是否为 GPU 扩展资源，包括 <vendor>/gpu 以及 mixed MIG 策略下的 nvidia.com/mig-<profile>
+func IsGPUResourceName(name corev1.ResourceName) bool {
+	s := string(name)
+	return strings.HasPrefix(s, clusterv1alpha1.ResourceNvidiaMIGPrefix) || strings.HasSuffix(s, "/gpu")
+}
+
+// summaryKey 是 GPU 型号的分组依据，memory 为每张卡的显存字节数，未知时为 -1
+type summaryKey struct {
+	resourceName corev1.ResourceName
+	product      string
+	memory       int64
+	migProfile   string
+}
+
+// Inventory 按资源名称、产品、显存与 MIG profile 统计节点上的 GPU，结果按上述字段排序。
+// nodeRequested 是每个节点上已调度 Pod 的资源请求之和，用于计算已分配与空闲的 GPU 数量。
+// 只统计容量大于 0 的 GPU 资源，设备插件上报的不健康设备会计入容量但不计入可分配数量。
+func Inventory(nodes []*corev1.Node, nodeRequested map[string]corev1.ResourceList) []clusterv1alpha1.GPUSummary {
+	summaries := make(map[summaryKey]*clusterv1alpha1.GPUSummary)
+	for _, node := range nodes {
+		for name, capacity := range node.Status.Capacity {
+			if !IsGPUResourceName(name) || capacity.Value() <= 0 {
+				continue
+			}
+
+			key := nodeSummaryKey(node, name)
+			summary, ok := summaries[key]
+			if !ok {
+				summary = &clusterv1alpha1.GPUSummary{
+					ResourceName: key.resourceName,
+					Product:      key.product,
+					MIGProfile:   key.migProfile,
+				}
+				if key.memory >= 0 {
+					summary.Memory = resource.NewQuantity(key.memory, resource.BinarySI)
+				}
+				summaries[key] = summary
+			}
+
+			allocatable := node.Status.Allocatable[name]
+			requested := nodeRequested[node.Name][name]
+			summary.NodeCount++
+			summary.Allocatable += allocatable.Value()
+			summary.Allocated += requested.Value()
+			summary.Free += max(allocatable.Value()-requested.Value(), 0)
+		}
+	}
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	keys := make([]summaryKey, 0, len(summaries))
+	for key := range summaries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.resourceName != b.resourceName {
+			return a.resourceName < b.resourceName
+		}
+		if a.product != b.product {
+			return a.product < b.product
+		}
+		if a.memory != b.memory {
+			return a.memory < b.memory
+		}
+		return a.migProfile < b.migProfile
+	})
+	inventory := make([]clusterv1alpha1.GPUSummary, 0, len(keys))
+	for _, key := range keys {
+		inventory = append(inventory, *summaries[key])
+	}
+	return inventory
+}
+
+// nodeSummaryKey 根据节点标签确定资源 name 所属的 GPU 型号
+func nodeSummaryKey(node *corev1.Node, name corev1.ResourceName) summaryKey {
+	key := summaryKey{
+		resourceName: name,
+		product:      node.Labels[string(name)+productLabelSuffix],
+		memory:       -1,
+	}
+	if mib, err := strconv.ParseInt(node.Labels[string(name)+memoryLabelSuffix], 10, 64); err == nil && mib > 0 {
+		key.memory = mib * 1024 * 1024
+	}
+
+	switch {
+	case strings.HasPrefix(string(name), clusterv1alpha1.ResourceNvidiaMIGPrefix):
+		key.migProfile = strings.TrimPrefix(string(name), clusterv1alpha1.ResourceNvidiaMIGPrefix)
+	case name == clusterv1alpha1.ResourceNvidiaGPU:
+		// single MIG 策略下 MIG 设备仍以 nvidia.com/gpu 暴露，只能从产品名中识别 MIG profile
+		if i := strings.LastIndex(key.product, migProductSeparator); i >= 0 {
+			key.migProfile = key.product[i+len(migProductSeparator):]
+		}
+	}
+	return key
+}
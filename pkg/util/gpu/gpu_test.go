@@ -0,0 +1,216 @@
+package gpu
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+const (
+	mig1g10gb = corev1.ResourceName(clusterv1alpha1.ResourceNvidiaMIGPrefix + "1g.10gb")
+	mig3g40gb = corev1.ResourceName(clusterv1alpha1.ResourceNvidiaMIGPrefix + "3g.40gb")
+)
+
+func newNode(name string, labels map[string]string, capacity, allocatable map[corev1.ResourceName]int64) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: corev1.NodeStatus{
+			Capacity:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("64")},
+			Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("63")},
+		},
+	}
+	for resourceName, value := range capacity {
+		node.Status.Capacity[resourceName] = *resource.NewQuantity(value, resource.DecimalSI)
+	}
+	for resourceName, value := range allocatable {
+		node.Status.Allocatable[resourceName] = *resource.NewQuantity(value, resource.DecimalSI)
+	}
+	return node
+}
+
+func mib(value int64) *resource.Quantity {
+	return resource.NewQuantity(value*1024*1024, resource.BinarySI)
+}
+
+func TestInventory(t *testing.T) {
+	a100Labels := map[string]string{
+		"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-80GB",
+		"nvidia.com/gpu.memory":  "81920",
+	}
+
+	tests := []struct {
+		name          string
+		nodes         []*corev1.Node
+		nodeRequested map[string]corev1.ResourceList
+		expected      []clusterv1alpha1.GPUSummary
+	}{
+		{
+			name: "no gpu",
+			nodes: []*corev1.Node{
+				newNode("cpu", nil, nil, nil),
+				newNode("zero", a100Labels, map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 0}, nil),
+			},
+			expected: nil,
+		},
+		{
+			name: "full gpus of the same product are grouped",
+			nodes: []*corev1.Node{
+				newNode("a100-1", a100Labels,
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 8},
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 8}),
+				// 一张卡不健康，计入容量但不计入可分配数量
+				newNode("a100-2", a100Labels,
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 8},
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 7}),
+			},
+			nodeRequested: map[string]corev1.ResourceList{
+				"a100-1": {clusterv1alpha1.ResourceNvidiaGPU: resource.MustParse("3")},
+				"a100-2": {clusterv1alpha1.ResourceNvidiaGPU: resource.MustParse("7")},
+			},
+			expected: []clusterv1alpha1.GPUSummary{{
+				ResourceName: clusterv1alpha1.ResourceNvidiaGPU,
+				Product:      "NVIDIA-A100-SXM4-80GB",
+				Memory:       mib(81920),
+				NodeCount:    2,
+				Allocatable:  15,
+				Allocated:    10,
+				Free:         5,
+			}},
+		},
+		{
+			name: "different products and unknown memory",
+			nodes: []*corev1.Node{
+				newNode("a100", a100Labels,
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 4},
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 4}),
+				newNode("t4", map[string]string{"nvidia.com/gpu.product": "Tesla-T4"},
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 2},
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 2}),
+				newNode("amd", nil,
+					map[corev1.ResourceName]int64{"amd.com/gpu": 1},
+					map[corev1.ResourceName]int64{"amd.com/gpu": 1}),
+			},
+			expected: []clusterv1alpha1.GPUSummary{
+				{ResourceName: "amd.com/gpu", NodeCount: 1, Allocatable: 1, Free: 1},
+				{ResourceName: clusterv1alpha1.ResourceNvidiaGPU, Product: "NVIDIA-A100-SXM4-80GB", Memory: mib(81920), NodeCount: 1, Allocatable: 4, Free: 4},
+				{ResourceName: clusterv1alpha1.ResourceNvidiaGPU, Product: "Tesla-T4", NodeCount: 1, Allocatable: 2, Free: 2},
+			},
+		},
+		{
+			name: "mixed mig strategy",
+			nodes: []*corev1.Node{
+				newNode("mixed", map[string]string{
+					"nvidia.com/mig-1g.10gb.product": "NVIDIA-A100-SXM4-80GB-MIG-1g.10gb",
+					"nvidia.com/mig-1g.10gb.memory":  "9728",
+					"nvidia.com/mig-3g.40gb.product": "NVIDIA-A100-SXM4-80GB-MIG-3g.40gb",
+					"nvidia.com/mig-3g.40gb.memory":  "40192",
+				},
+					map[corev1.ResourceName]int64{mig1g10gb: 4, mig3g40gb: 1},
+					map[corev1.ResourceName]int64{mig1g10gb: 4, mig3g40gb: 1}),
+			},
+			nodeRequested: map[string]corev1.ResourceList{
+				"mixed": {mig1g10gb: resource.MustParse("1"), mig3g40gb: resource.MustParse("1")},
+			},
+			expected: []clusterv1alpha1.GPUSummary{
+				{ResourceName: mig1g10gb, Product: "NVIDIA-A100-SXM4-80GB-MIG-1g.10gb", Memory: mib(9728), MIGProfile: "1g.10gb", NodeCount: 1, Allocatable: 4, Allocated: 1, Free: 3},
+				{ResourceName: mig3g40gb, Product: "NVIDIA-A100-SXM4-80GB-MIG-3g.40gb", Memory: mib(40192), MIGProfile: "3g.40gb", NodeCount: 1, Allocatable: 1, Allocated: 1, Free: 0},
+			},
+		},
+		{
+			name: "single mig strategy is recognized from the product name",
+			nodes: []*corev1.Node{
+				newNode("single", map[string]string{
+					"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-40GB-MIG-1g.5gb",
+					"nvidia.com/gpu.memory":  "4864",
+				},
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 7},
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 7}),
+			},
+			expected: []clusterv1alpha1.GPUSummary{
+				{ResourceName: clusterv1alpha1.ResourceNvidiaGPU, Product: "NVIDIA-A100-SXM4-40GB-MIG-1g.5gb", Memory: mib(4864), MIGProfile: "1g.5gb", NodeCount: 1, Allocatable: 7, Free: 7},
+			},
+		},
+		{
+			name: "over-requested gpus are not counted as negative free",
+			nodes: []*corev1.Node{
+				newNode("a100", a100Labels,
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 8},
+					map[corev1.ResourceName]int64{clusterv1alpha1.ResourceNvidiaGPU: 6}),
+			},
+			nodeRequested: map[string]corev1.ResourceList{
+				"a100": {clusterv1alpha1.ResourceNvidiaGPU: resource.MustParse("8")},
+			},
+			expected: []clusterv1alpha1.GPUSummary{
+				{ResourceName: clusterv1alpha1.ResourceNvidiaGPU, Product: "NVIDIA-A100-SXM4-80GB", Memory: mib(81920), NodeCount: 1, Allocatable: 6, Allocated: 8, Free: 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inventory := Inventory(tt.nodes, tt.nodeRequested)
+			if !apiequality.Semantic.DeepEqual(inventory, tt.expected) {
+				t.Errorf("expected inventory\n%+v\ngot\n%+v", tt.expected, inventory)
+			}
+		})
+	}
+}
+
+func TestNodeSummaryKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		labels       map[string]string
+		resourceName corev1.ResourceName
+		expected     summaryKey
+	}{
+		{
+			name:         "full gpu",
+			labels:       map[string]string{"nvidia.com/gpu.product": "NVIDIA-H100-80GB-HBM3", "nvidia.com/gpu.memory": "81559"},
+			resourceName: clusterv1alpha1.ResourceNvidiaGPU,
+			expected:     summaryKey{resourceName: clusterv1alpha1.ResourceNvidiaGPU, product: "NVIDIA-H100-80GB-HBM3", memory: 81559 * 1024 * 1024},
+		},
+		{
+			name:         "missing labels",
+			resourceName: clusterv1alpha1.ResourceNvidiaGPU,
+			expected:     summaryKey{resourceName: clusterv1alpha1.ResourceNvidiaGPU, memory: -1},
+		},
+		{
+			name:         "invalid memory label",
+			labels:       map[string]string{"nvidia.com/gpu.memory": "unknown"},
+			resourceName: clusterv1alpha1.ResourceNvidiaGPU,
+			expected:     summaryKey{resourceName: clusterv1alpha1.ResourceNvidiaGPU, memory: -1},
+		},
+		{
+			name:         "mixed mig resource",
+			labels:       map[string]string{"nvidia.com/mig-1g.10gb.product": "NVIDIA-A100-SXM4-80GB-MIG-1g.10gb", "nvidia.com/mig-1g.10gb.memory": "9728"},
+			resourceName: mig1g10gb,
+			expected:     summaryKey{resourceName: mig1g10gb, product: "NVIDIA-A100-SXM4-80GB-MIG-1g.10gb", memory: 9728 * 1024 * 1024, migProfile: "1g.10gb"},
+		},
+		{
+			name:         "single mig product name",
+			labels:       map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-40GB-MIG-3g.20gb"},
+			resourceName: clusterv1alpha1.ResourceNvidiaGPU,
+			expected:     summaryKey{resourceName: clusterv1alpha1.ResourceNvidiaGPU, product: "NVIDIA-A100-SXM4-40GB-MIG-3g.20gb", memory: -1, migProfile: "3g.20gb"},
+		},
+		{
+			name:         "mig product names of other vendors are not parsed",
+			labels:       map[string]string{"example.com/gpu.product": "Example-MIG-1g"},
+			resourceName: "example.com/gpu",
+			expected:     summaryKey{resourceName: "example.com/gpu", product: "Example-MIG-1g", memory: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: tt.labels}}
+			if key := nodeSummaryKey(node, tt.resourceName); key != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, key)
+			}
+		})
+	}
+}
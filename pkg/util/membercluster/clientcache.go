@@ -0,0 +1,247 @@
+package membercluster
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// ClusterClients 是访问同一个成员集群的一组客户端，它们共享同一份 rest.Config
+type ClusterClients struct {
+	// Config 是根据 Cluster 连接信息构造的配置，调用方不应修改
+	Config *rest.Config
+	// Kube 是成员集群的类型化客户端
+	Kube kubernetes.Interface
+	// Dynamic 是成员集群的动态客户端
+	Dynamic dynamic.Interface
+	// Discovery 是成员集群的发现客户端，不做缓存，每次调用都会访问成员集群
+	Discovery discovery.DiscoveryInterface
+	// Transport 用于反向代理，支持 ProxyURL 与 upgrade 请求，多个请求之间复用连接
+	Transport http.RoundTripper
+}
+
+// connectionSpec 是 ClusterSpec 中影响连接方式的字段，任一字段变化都需要重建客户端
+type connectionSpec struct {
+	APIEndpoint                 string
+	SecretRef                   *clusterv1alpha1.LocalSecretReference
+	InsecureSkipTLSVerification bool
+	ProxyURL                    string
+	ProxyHeader                 map[string]string
+}
+
+func newConnectionSpec(cluster *clusterv1alpha1.Cluster) connectionSpec {
+	return connectionSpec{
+		APIEndpoint:                 cluster.Spec.APIEndpoint,
+		SecretRef:                   cluster.Spec.SecretRef.DeepCopy(),
+		InsecureSkipTLSVerification: cluster.Spec.InsecureSkipTLSVerification,
+		ProxyURL:                    cluster.Spec.ProxyURL,
+		ProxyHeader:                 cluster.Spec.ProxyHeader,
+	}
+}
+
+type clientCacheEntry struct {
+	spec    connectionSpec
+	clients *ClusterClients
+	// staleReason 非空表示凭证已经变化，下次获取时需要重建
+	staleReason string
+}
+
+// ClientCache 按 Cluster 名称缓存访问成员集群的客户端，可以并发使用。
+// Cluster 的连接信息变化时在下次获取时重建；SecretRef 指向的 Secret 中 token 或 caBundle 变化时，
+// 通过 SecretEventHandler 使引用它的缓存项失效，从而支持凭证轮换。
+type ClientCache struct {
+	secretGetter SecretGetterFunc
+	// timeout 是类型化、动态与发现客户端的请求超时，0 表示不超时
+	timeout time.Duration
+
+	lock    sync.Mutex
+	entries map[string]*clientCacheEntry
+	// clustersBySecret 记录每个 Secret（namespace/name）被哪些 Cluster 引用
+	clustersBySecret map[string]sets.Set[string]
+}
+
+// NewClientCache 创建成员集群客户端缓存，secretGetter 用于读取 SecretRef 指向的凭证
+func NewClientCache(secretGetter SecretGetterFunc, timeout time.Duration) *ClientCache {
+	registerMetrics()
+	return &ClientCache{
+		secretGetter:     secretGetter,
+		timeout:          timeout,
+		entries:          map[string]*clientCacheEntry{},
+		clustersBySecret: map[string]sets.Set[string]{},
+	}
+}
+
+// Get 返回 Cluster 对应的客户端，缓存项不存在或已过期时重新构造
+func (c *ClientCache) Get(cluster *clusterv1alpha1.Cluster) (*ClusterClients, error) {
+	spec := newConnectionSpec(cluster)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	reason := rebuildReasonNew
+	if entry, ok := c.entries[cluster.Name]; ok {
+		switch {
+		case entry.staleReason != "":
+			reason = entry.staleReason
+		case !apiequality.Semantic.DeepEqual(entry.spec, spec):
+			reason = rebuildReasonClusterChange
+		default:
+			clientCacheHits.WithLabelValues(cluster.Name).Inc()
+			return entry.clients, nil
+		}
+	}
+
+	clients, err := c.newClusterClients(cluster)
+	if err != nil {
+		return nil, err
+	}
+	clientCacheRebuilds.WithLabelValues(cluster.Name, reason).Inc()
+
+	c.removeLocked(cluster.Name)
+	c.entries[cluster.Name] = &clientCacheEntry{spec: spec, clients: clients}
+	if spec.SecretRef != nil {
+		key := secretKey(spec.SecretRef.Namespace, spec.SecretRef.Name)
+		if c.clustersBySecret[key] == nil {
+			c.clustersBySecret[key] = sets.New[string]()
+		}
+		c.clustersBySecret[key].Insert(cluster.Name)
+	}
+	return clients, nil
+}
+
+// Remove 删除 Cluster 对应的缓存项，通常在 Cluster 被删除时调用
+func (c *ClientCache) Remove(clusterName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.removeLocked(clusterName)
+}
+
+func (c *ClientCache) removeLocked(clusterName string) {
+	entry, ok := c.entries[clusterName]
+	if !ok {
+		return
+	}
+	delete(c.entries, clusterName)
+	// 旧的代理连接不会再被复用，正在进行的请求不受影响
+	utilnet.CloseIdleConnectionsFor(entry.clients.Transport)
+	if entry.spec.SecretRef == nil {
+		return
+	}
+	key := secretKey(entry.spec.SecretRef.Namespace, entry.spec.SecretRef.Name)
+	if clusters, ok := c.clustersBySecret[key]; ok {
+		clusters.Delete(clusterName)
+		if clusters.Len() == 0 {
+			delete(c.clustersBySecret, key)
+		}
+	}
+}
+
+// SecretEventHandler 返回需要注册到 Secret Informer 上的事件处理器，
+// token 或 caBundle 变化以及 Secret 被删除时，引用它的缓存项会失效
+func (c *ClientCache) SecretEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldSecret, ok := oldObj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+			newSecret, ok := newObj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+			if credentialsChanged(oldSecret, newSecret) {
+				c.invalidateSecret(newSecret.Namespace, newSecret.Name)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if secret, ok := obj.(*corev1.Secret); ok {
+				c.invalidateSecret(secret.Namespace, secret.Name)
+			}
+		},
+	}
+}
+
+func (c *ClientCache) invalidateSecret(namespace, name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for clusterName := range c.clustersBySecret[secretKey(namespace, name)] {
+		if entry, ok := c.entries[clusterName]; ok {
+			entry.staleReason = rebuildReasonSecretChange
+		}
+	}
+}
+
+// newClusterClients 根据 Cluster 构造一组共享配置的客户端
+func (c *ClientCache) newClusterClients(cluster *clusterv1alpha1.Cluster) (*ClusterClients, error) {
+	config, err := BuildClusterConfig(cluster, c.secretGetter)
+	if err != nil {
+		return nil, err
+	}
+	// 反向代理的连接可能是 watch 或 exec 等长连接，不设置超时
+	transport, err := NewProxyTransport(config)
+	if err != nil {
+		return nil, fmt.Errorf("cluster %s: %w", cluster.Name, err)
+	}
+
+	config.Timeout = c.timeout
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cluster %s: %w", cluster.Name, err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cluster %s: %w", cluster.Name, err)
+	}
+
+	return &ClusterClients{
+		Config:    config,
+		Kube:      kubeClient,
+		Dynamic:   dynamicClient,
+		Discovery: kubeClient.Discovery(),
+		Transport: transport,
+	}, nil
+}
+
+// NewProxyTransport 根据 rest.Config 构造用于反向代理的 Transport，支持代理与 upgrade 请求。
+// 认证信息由代理在请求头中设置，因此这里只使用 TLS 与连接相关的配置。
+func NewProxyTransport(config *rest.Config) (http.RoundTripper, error) {
+	tlsConfig, err := rest.TLSConfigFor(config)
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{
+		TLSClientConfig: tlsConfig,
+		Proxy:           config.Proxy,
+	}
+	if config.Dial != nil {
+		transport.DialContext = config.Dial
+	}
+	return utilnet.SetTransportDefaults(transport), nil
+}
+
+// credentialsChanged 判断 Secret 中的 token 或 caBundle 是否发生变化
+func credentialsChanged(oldSecret, newSecret *corev1.Secret) bool {
+	return !bytes.Equal(oldSecret.Data[clusterv1alpha1.SecretTokenKey], newSecret.Data[clusterv1alpha1.SecretTokenKey]) ||
+		!bytes.Equal(oldSecret.Data[clusterv1alpha1.SecretCADataKey], newSecret.Data[clusterv1alpha1.SecretCADataKey])
+}
+
+func secretKey(namespace, name string) string {
+	return namespace + "/" + name
+}
@@ -0,0 +1,111 @@
+package membercluster
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// SecretGetterFunc 根据命名空间与名称获取 Secret，通常由 Secret Lister 或 clientset 提供
+type SecretGetterFunc func(namespace, name string) (*corev1.Secret, error)
+
+// EndpointURL 将 Cluster.Spec.APIEndpoint 规范化为 URL。
+// APIEndpoint 可以是 hostname、hostname:port、IP、IP:port，未携带协议时默认使用 https。
+func EndpointURL(apiEndpoint string) (*url.URL, error) {
+	if len(apiEndpoint) == 0 {
+		return nil, fmt.Errorf("the api endpoint of cluster is empty")
+	}
+	if !strings.Contains(apiEndpoint, "://") {
+		apiEndpoint = "https://" + apiEndpoint
+	}
+	u, err := url.Parse(apiEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse api endpoint %q: %w", apiEndpoint, err)
+	}
+	return u, nil
+}
+
+// ProxyHeader 将 Cluster.Spec.ProxyHeader 转换为 http.Header，逗号分隔的值会拆分为多个值
+func ProxyHeader(proxyHeader map[string]string) http.Header {
+	if len(proxyHeader) == 0 {
+		return nil
+	}
+	header := make(http.Header, len(proxyHeader))
+	for key, value := range proxyHeader {
+		for _, v := range strings.Split(value, ",") {
+			header.Add(key, strings.TrimSpace(v))
+		}
+	}
+	return header
+}
+
+// BuildClusterConfig 根据 Cluster 的连接信息构造访问成员集群的 rest.Config。
+// 凭证取自 SecretRef 指向的 Secret 中的 token 与 caBundle；
+// 如果设置了 ProxyURL，所有连接（包括 upgrade 连接）都经由代理建立，并携带 ProxyHeader。
+func BuildClusterConfig(cluster *clusterv1alpha1.Cluster, secretGetter SecretGetterFunc) (*rest.Config, error) {
+	endpoint, err := EndpointURL(cluster.Spec.APIEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("cluster %s: %w", cluster.Name, err)
+	}
+
+	config := &rest.Config{
+		Host: endpoint.String(),
+	}
+
+	if cluster.Spec.SecretRef != nil {
+		secret, token, err := getSecretToken(cluster, cluster.Spec.SecretRef, secretGetter)
+		if err != nil {
+			return nil, err
+		}
+		config.BearerToken = token
+		config.TLSClientConfig.CAData = secret.Data[clusterv1alpha1.SecretCADataKey]
+	}
+
+	if cluster.Spec.InsecureSkipTLSVerification {
+		config.TLSClientConfig.Insecure = true
+		config.TLSClientConfig.CAData = nil
+	}
+
+	if len(cluster.Spec.ProxyURL) > 0 {
+		dial, err := NewProxyDialer(cluster.Spec.ProxyURL, ProxyHeader(cluster.Spec.ProxyHeader))
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %w", cluster.Name, err)
+		}
+		config.Dial = dial
+		// 代理已经在 Dial 中处理，这里显式关闭环境变量中的代理，避免重复代理
+		config.Proxy = func(*http.Request) (*url.URL, error) { return nil, nil }
+	}
+
+	return config, nil
+}
+
+// ImpersonatorToken 返回 ImpersonatorSecretRef 指向的 Secret 中的 token。
+// 该 token 属于成员集群中只拥有 impersonate 权限的 ServiceAccount，
+// 控制面以它携带 Impersonate-* 头代表 kubellm 用户访问成员集群。
+func ImpersonatorToken(cluster *clusterv1alpha1.Cluster, secretGetter SecretGetterFunc) (string, error) {
+	if cluster.Spec.ImpersonatorSecretRef == nil {
+		return "", fmt.Errorf("cluster %s has no impersonator secret", cluster.Name)
+	}
+	_, token, err := getSecretToken(cluster, cluster.Spec.ImpersonatorSecretRef, secretGetter)
+	return token, err
+}
+
+// getSecretToken 读取 ref 指向的 Secret 并返回其中非空的 token
+func getSecretToken(cluster *clusterv1alpha1.Cluster, ref *clusterv1alpha1.LocalSecretReference, secretGetter SecretGetterFunc) (*corev1.Secret, string, error) {
+	secret, err := secretGetter(ref.Namespace, ref.Name)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get secret %s/%s of cluster %s: %w", ref.Namespace, ref.Name, cluster.Name, err)
+	}
+	token, ok := secret.Data[clusterv1alpha1.SecretTokenKey]
+	if !ok || len(token) == 0 {
+		return nil, "", fmt.Errorf("the secret %s/%s of cluster %s has no %q",
+			secret.Namespace, secret.Name, cluster.Name, clusterv1alpha1.SecretTokenKey)
+	}
+	return secret, string(token), nil
+}
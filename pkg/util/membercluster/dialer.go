@@ -0,0 +1,116 @@
+package membercluster
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// DialFunc 是建立 TCP 连接的函数
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// NewProxyDialer 返回一个经由代理建立连接的 DialFunc。
+// 支持 http、https 代理（CONNECT 隧道，携带 header 作为 Proxy 头）与 socks5 代理。
+// 与 http.Transport.Proxy 不同，它同样适用于 exec、port-forward 等 upgrade 连接。
+func NewProxyDialer(proxyURL string, header http.Header) (DialFunc, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse proxy url %q: %w", proxyURL, err)
+	}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+
+	switch u.Scheme {
+	case "socks5":
+		socksDialer, err := proxy.FromURL(u, dialer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create socks5 dialer: %w", err)
+		}
+		contextDialer, ok := socksDialer.(proxy.ContextDialer)
+		if !ok {
+			return nil, fmt.Errorf("socks5 dialer does not support context")
+		}
+		return contextDialer.DialContext, nil
+	case "http", "https":
+		return func(ctx context.Context, network, address string) (net.Conn, error) {
+			return dialWithHTTPProxy(ctx, dialer, u, header, address)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q", u.Scheme)
+	}
+}
+
+// dialWithHTTPProxy 通过 CONNECT 方法与 HTTP(S) 代理建立到 address 的隧道
+func dialWithHTTPProxy(ctx context.Context, dialer *net.Dialer, proxyURL *url.URL, header http.Header, address string) (net.Conn, error) {
+	proxyAddress := proxyURL.Host
+	if proxyURL.Port() == "" {
+		port := "80"
+		if proxyURL.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddress = net.JoinHostPort(proxyURL.Hostname(), port)
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", proxyAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial proxy %s: %w", proxyAddress, err)
+	}
+	if proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to handshake with proxy %s: %w", proxyAddress, err)
+		}
+		conn = tlsConn
+	}
+
+	connectReq := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: header.Clone(),
+	}
+	if connectReq.Header == nil {
+		connectReq.Header = http.Header{}
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		connectReq.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer func() { _ = conn.SetDeadline(time.Time{}) }()
+	}
+	if err := connectReq.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to send CONNECT request to proxy %s: %w", proxyAddress, err)
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, connectReq)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to read CONNECT response from proxy %s: %w", proxyAddress, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused to connect to %s: %s", proxyAddress, address, resp.Status)
+	}
+	if reader.Buffered() > 0 {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s sent unexpected data after CONNECT response", proxyAddress)
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,60 @@
+package membercluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ClusterPropertyName 是 about.k8s.io 中约定的集群 ID 所在的 ClusterProperty 名称，
+// 参见 https://github.com/kubernetes-sigs/about-api
+const ClusterPropertyName = "cluster.clusterset.k8s.io"
+
+const clusterPropertyPath = "/apis/about.k8s.io/v1alpha1/clusterproperties/" + ClusterPropertyName
+
+// clusterProperty 只包含读取集群 ID 需要的字段，避免依赖 about-api 的类型定义
+type clusterProperty struct {
+	Spec struct {
+		Value string `json:"value"`
+	} `json:"spec"`
+}
+
+// GetClusterID 按 ClusterSpec.ID 约定的顺序收集成员集群的 ID：
+// 优先使用名为 cluster.clusterset.k8s.io 的 ClusterProperty 的值，
+// 成员集群未安装 ClusterProperty API 或未定义该对象时，使用 kube-system 命名空间的 UID。
+func GetClusterID(ctx context.Context, client kubernetes.Interface) (string, error) {
+	id, err := getClusterPropertyID(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	if len(id) > 0 {
+		return id, nil
+	}
+
+	ns, err := client.CoreV1().Namespaces().Get(ctx, metav1.NamespaceSystem, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get namespace %s: %w", metav1.NamespaceSystem, err)
+	}
+	return string(ns.UID), nil
+}
+
+func getClusterPropertyID(ctx context.Context, client kubernetes.Interface) (string, error) {
+	body, err := client.Discovery().RESTClient().Get().AbsPath(clusterPropertyPath).DoRaw(ctx)
+	if err != nil {
+		// 未安装 ClusterProperty API 与未定义该对象都会返回 404
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get ClusterProperty %s: %w", ClusterPropertyName, err)
+	}
+
+	property := &clusterProperty{}
+	if err := json.Unmarshal(body, property); err != nil {
+		return "", fmt.Errorf("failed to decode ClusterProperty %s: %w", ClusterPropertyName, err)
+	}
+	return property.Spec.Value, nil
+}
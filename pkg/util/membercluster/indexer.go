@@ -0,0 +1,71 @@
+package membercluster
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// clusterIndexedFields 是 Cluster Informer 中建立索引的字段，索引名称与字段选择器的路径一致
+var clusterIndexedFields = []string{
+	clusterv1alpha1.ClusterFieldID,
+	clusterv1alpha1.ClusterFieldProvider,
+	clusterv1alpha1.ClusterFieldRegion,
+	clusterv1alpha1.ClusterFieldReady,
+}
+
+// ClusterFieldValue 返回 Cluster 在字段选择器中对应字段的值，与 kubellm-apiserver 的字段选择器语义一致
+func ClusterFieldValue(cluster *clusterv1alpha1.Cluster, field string) (string, error) {
+	switch field {
+	case clusterv1alpha1.ClusterFieldID:
+		return cluster.Spec.ID, nil
+	case clusterv1alpha1.ClusterFieldProvider:
+		return cluster.Spec.Provider, nil
+	case clusterv1alpha1.ClusterFieldRegion:
+		return cluster.Spec.Region, nil
+	case clusterv1alpha1.ClusterFieldReady:
+		return strconv.FormatBool(meta.IsStatusConditionTrue(cluster.Status.Conditions, clusterv1alpha1.ClusterConditionReady)), nil
+	default:
+		return "", fmt.Errorf("field %s of cluster is not indexed", field)
+	}
+}
+
+// ClusterIndexers 返回按 spec.id、spec.provider、spec.region 与 status.ready 索引 Cluster 的 Indexers，
+// 调用方可以通过 Indexer.ByIndex(clusterv1alpha1.ClusterFieldRegion, "cn-shanghai") 在本地缓存中过滤集群
+func ClusterIndexers() cache.Indexers {
+	indexers := cache.Indexers{}
+	for _, field := range clusterIndexedFields {
+		indexers[field] = func(obj interface{}) ([]string, error) {
+			cluster, ok := obj.(*clusterv1alpha1.Cluster)
+			if !ok {
+				return nil, fmt.Errorf("given object is not a Cluster")
+			}
+			value, err := ClusterFieldValue(cluster, field)
+			if err != nil {
+				return nil, err
+			}
+			return []string{value}, nil
+		}
+	}
+	return indexers
+}
+
+// AddClusterIndexers 向 Cluster Informer 添加 ClusterIndexers 中尚未存在的索引，多个控制器共享同一个 Informer 时可以重复调用。
+// 与其他 Indexer 一样，需要在 Informer 启动之前调用。
+func AddClusterIndexers(informer cache.SharedIndexInformer) error {
+	existing := informer.GetIndexer().GetIndexers()
+	missing := cache.Indexers{}
+	for name, indexFunc := range ClusterIndexers() {
+		if _, ok := existing[name]; !ok {
+			missing[name] = indexFunc
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return informer.AddIndexers(missing)
+}
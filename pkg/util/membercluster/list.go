@@ -0,0 +1,40 @@
+package membercluster
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/pager"
+)
+
+// ListNodes 列出成员集群的所有节点，优先读取 API Server 缓存
+func ListNodes(ctx context.Context, client kubernetes.Interface) ([]*corev1.Node, error) {
+	var nodes []*corev1.Node
+	nodePager := pager.New(func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return client.CoreV1().Nodes().List(ctx, opts)
+	})
+	err := nodePager.EachListItem(ctx, metav1.ListOptions{ResourceVersion: "0"}, func(obj runtime.Object) error {
+		nodes = append(nodes, obj.(*corev1.Node))
+		return nil
+	})
+	return nodes, err
+}
+
+// ListPods 列出成员集群中所有未结束的 Pod，优先读取 API Server 缓存
+func ListPods(ctx context.Context, client kubernetes.Interface) ([]*corev1.Pod, error) {
+	var pods []*corev1.Pod
+	podPager := pager.New(func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, opts)
+	})
+	err := podPager.EachListItem(ctx, metav1.ListOptions{ResourceVersion: "0"}, func(obj runtime.Object) error {
+		pod := obj.(*corev1.Pod)
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			pods = append(pods, pod)
+		}
+		return nil
+	})
+	return pods, err
+}
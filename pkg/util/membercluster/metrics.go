@@ -0,0 +1,51 @@
+package membercluster
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const metricsSubsystem = "member_client_cache"
+
+// 客户端重建的原因
+const (
+	rebuildReasonNew           = "new"
+	rebuildReasonClusterChange = "cluster_changed"
+	rebuildReasonSecretChange  = "secret_changed"
+)
+
+var (
+	clientCacheHits = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kubellm",
+			Subsystem:      metricsSubsystem,
+			Name:           "hits_total",
+			Help:           "Number of member cluster client lookups served from the cache.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"cluster"},
+	)
+
+	clientCacheRebuilds = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kubellm",
+			Subsystem:      metricsSubsystem,
+			Name:           "rebuilds_total",
+			Help:           "Number of member cluster clients built because the entry was missing or stale, partitioned by reason.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"cluster", "reason"},
+	)
+)
+
+var registerMetricsOnce sync.Once
+
+// registerMetrics 把客户端缓存的指标注册到 legacyregistry，可重复调用
+func registerMetrics() {
+	registerMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(clientCacheHits)
+		legacyregistry.MustRegister(clientCacheRebuilds)
+	})
+}
@@ -0,0 +1,76 @@
+package modeling
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// Modeling 根据 Cluster.Spec.ResourceModels 将节点的剩余资源划分到不同的等级。
+// 每个等级的区间为 [Min, Max)，第一个等级的 Min 视为 0，最后一个等级的 Max 视为无穷大，
+// 因此判断一个节点属于哪个等级时只需要比较各个区间的 Min。
+type Modeling struct {
+	// models 按 Grade 升序排列
+	models []clusterv1alpha1.ResourceModel
+}
+
+// New 创建 Modeling，models 会被复制并按 Grade 升序排列
+func New(models []clusterv1alpha1.ResourceModel) *Modeling {
+	sorted := make([]clusterv1alpha1.ResourceModel, len(models))
+	for i := range models {
+		models[i].DeepCopyInto(&sorted[i])
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Grade < sorted[j].Grade
+	})
+	return &Modeling{models: sorted}
+}
+
+// Classify 返回 available 所属的等级，即每个区间都满足 available >= Min 的最高等级。
+// available 中缺失的资源视为 0；没有定义任何等级时返回 false。
+func (m *Modeling) Classify(available corev1.ResourceList) (uint, bool) {
+	for i := len(m.models) - 1; i > 0; i-- {
+		if satisfies(m.models[i], available) {
+			return m.models[i].Grade, true
+		}
+	}
+	if len(m.models) == 0 {
+		return 0, false
+	}
+	// 第一个等级的 Min 视为 0，任何节点至少属于第一个等级
+	return m.models[0].Grade, true
+}
+
+// AllocatableModelings 统计每个等级中的节点数量，结果包含所有等级（数量可能为 0），并按 Grade 升序排列。
+// available 是每个节点的剩余资源，即节点可分配资源减去已调度 Pod 的资源请求。
+func (m *Modeling) AllocatableModelings(available []corev1.ResourceList) []clusterv1alpha1.AllocatableModeling {
+	if len(m.models) == 0 {
+		return nil
+	}
+
+	modelings := make([]clusterv1alpha1.AllocatableModeling, len(m.models))
+	index := make(map[uint]int, len(m.models))
+	for i, model := range m.models {
+		modelings[i].Grade = model.Grade
+		index[model.Grade] = i
+	}
+	for _, resources := range available {
+		if grade, ok := m.Classify(resources); ok {
+			modelings[index[grade]].Count++
+		}
+	}
+	return modelings
+}
+
+// satisfies 判断 available 是否满足 model 中每个区间的 Min
+func satisfies(model clusterv1alpha1.ResourceModel, available corev1.ResourceList) bool {
+	for _, r := range model.Ranges {
+		quantity := available[r.Name]
+		if quantity.Cmp(r.Min) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,206 @@
+package modeling
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+func newModel(grade uint, cpuMin, cpuMax, memoryMin, memoryMax string) clusterv1alpha1.ResourceModel {
+	return clusterv1alpha1.ResourceModel{
+		Grade: grade,
+		Ranges: []clusterv1alpha1.ResourceModelRange{
+			{Name: corev1.ResourceCPU, Min: resource.MustParse(cpuMin), Max: resource.MustParse(cpuMax)},
+			{Name: corev1.ResourceMemory, Min: resource.MustParse(memoryMin), Max: resource.MustParse(memoryMax)},
+		},
+	}
+}
+
+func resources(cpu, memory string) corev1.ResourceList {
+	list := corev1.ResourceList{}
+	if len(cpu) > 0 {
+		list[corev1.ResourceCPU] = resource.MustParse(cpu)
+	}
+	if len(memory) > 0 {
+		list[corev1.ResourceMemory] = resource.MustParse(memory)
+	}
+	return list
+}
+
+// defaultModels 是三个连续的等级：[0,4) [4,16) [16,∞)，内存为 [0,16Gi) [16Gi,64Gi) [64Gi,∞)
+func defaultModels() []clusterv1alpha1.ResourceModel {
+	return []clusterv1alpha1.ResourceModel{
+		newModel(0, "0", "4", "0", "16Gi"),
+		newModel(1, "4", "16", "16Gi", "64Gi"),
+		newModel(2, "16", "32", "64Gi", "128Gi"),
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name          string
+		models        []clusterv1alpha1.ResourceModel
+		available     corev1.ResourceList
+		expectedGrade uint
+		expectedOK    bool
+	}{
+		{
+			name:       "no models",
+			models:     nil,
+			available:  resources("8", "32Gi"),
+			expectedOK: false,
+		},
+		{
+			name:          "empty resources belong to the first grade",
+			models:        defaultModels(),
+			available:     corev1.ResourceList{},
+			expectedGrade: 0,
+			expectedOK:    true,
+		},
+		{
+			name:          "just below the lower bound of the second grade",
+			models:        defaultModels(),
+			available:     resources("3999m", "64Gi"),
+			expectedGrade: 0,
+			expectedOK:    true,
+		},
+		{
+			name:          "min is inclusive",
+			models:        defaultModels(),
+			available:     resources("4", "16Gi"),
+			expectedGrade: 1,
+			expectedOK:    true,
+		},
+		{
+			name:          "max is exclusive",
+			models:        defaultModels(),
+			available:     resources("16", "64Gi"),
+			expectedGrade: 2,
+			expectedOK:    true,
+		},
+		{
+			name:          "every range must be satisfied",
+			models:        defaultModels(),
+			available:     resources("32", "8Gi"),
+			expectedGrade: 0,
+			expectedOK:    true,
+		},
+		{
+			name:          "missing resource is treated as zero",
+			models:        defaultModels(),
+			available:     resources("32", ""),
+			expectedGrade: 0,
+			expectedOK:    true,
+		},
+		{
+			name:          "overflow beyond max of the last grade",
+			models:        defaultModels(),
+			available:     resources("1024", "4Ti"),
+			expectedGrade: 2,
+			expectedOK:    true,
+		},
+		{
+			name:          "models are sorted by grade",
+			models:        []clusterv1alpha1.ResourceModel{defaultModels()[2], defaultModels()[0], defaultModels()[1]},
+			available:     resources("8", "32Gi"),
+			expectedGrade: 1,
+			expectedOK:    true,
+		},
+		{
+			name:          "min of the first grade acts as zero",
+			models:        []clusterv1alpha1.ResourceModel{newModel(0, "2", "4", "1Gi", "16Gi"), newModel(1, "4", "8", "16Gi", "32Gi")},
+			available:     resources("1", "512Mi"),
+			expectedGrade: 0,
+			expectedOK:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grade, ok := New(tt.models).Classify(tt.available)
+			if ok != tt.expectedOK {
+				t.Fatalf("expected ok %v, got %v", tt.expectedOK, ok)
+			}
+			if grade != tt.expectedGrade {
+				t.Errorf("expected grade %d, got %d", tt.expectedGrade, grade)
+			}
+		})
+	}
+}
+
+func TestAllocatableModelings(t *testing.T) {
+	tests := []struct {
+		name      string
+		models    []clusterv1alpha1.ResourceModel
+		available []corev1.ResourceList
+		expected  []clusterv1alpha1.AllocatableModeling
+	}{
+		{
+			name:      "no models",
+			available: []corev1.ResourceList{resources("8", "32Gi")},
+			expected:  nil,
+		},
+		{
+			name:     "no nodes",
+			models:   defaultModels(),
+			expected: []clusterv1alpha1.AllocatableModeling{{Grade: 0}, {Grade: 1}, {Grade: 2}},
+		},
+		{
+			name:   "nodes are counted by grade",
+			models: defaultModels(),
+			available: []corev1.ResourceList{
+				resources("0", "0"),
+				resources("4", "16Gi"),
+				resources("15", "63Gi"),
+				resources("16", "64Gi"),
+				// 超出最后一个等级的 Max 仍然计入最后一个等级
+				resources("256", "1Ti"),
+			},
+			expected: []clusterv1alpha1.AllocatableModeling{
+				{Grade: 0, Count: 1},
+				{Grade: 1, Count: 2},
+				{Grade: 2, Count: 2},
+			},
+		},
+		{
+			name: "grade numbers need not be contiguous",
+			models: []clusterv1alpha1.ResourceModel{
+				newModel(5, "16", "32", "64Gi", "128Gi"),
+				newModel(0, "0", "4", "0", "16Gi"),
+				newModel(2, "4", "16", "16Gi", "64Gi"),
+			},
+			available: []corev1.ResourceList{resources("2", "8Gi"), resources("8", "32Gi"), resources("8", "32Gi")},
+			expected: []clusterv1alpha1.AllocatableModeling{
+				{Grade: 0, Count: 1},
+				{Grade: 2, Count: 2},
+				{Grade: 5, Count: 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modelings := New(tt.models).AllocatableModelings(tt.available)
+			if !apiequality.Semantic.DeepEqual(modelings, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, modelings)
+			}
+		})
+	}
+}
+
+func TestNewDoesNotModifyModels(t *testing.T) {
+	models := []clusterv1alpha1.ResourceModel{defaultModels()[1], defaultModels()[0]}
+	m := New(models)
+	m.models[0].Ranges[0].Min = resource.MustParse("100")
+
+	if models[0].Grade != 1 || models[1].Grade != 0 {
+		t.Errorf("expected models to keep their order, got grades %d and %d", models[0].Grade, models[1].Grade)
+	}
+	if models[1].Ranges[0].Min.Cmp(resource.MustParse("0")) != 0 {
+		t.Errorf("expected models to be copied, got min %s", models[1].Ranges[0].Min.String())
+	}
+}
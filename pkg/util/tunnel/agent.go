@@ -0,0 +1,130 @@
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+	"k8s.io/apimachinery/pkg/util/proxy"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	clusterv1alpha1 "github.com/kubellm-io/kubellm/pkg/apis/cluster.kubellm.io/v1alpha1"
+)
+
+// Dial 由 agent 调用，通过 clusters/{name}/tunnel 子资源的 upgrade 请求与控制面建立隧道连接。
+// config 是 agent 访问控制面的配置，请求携带 agent 自身的凭证。
+func Dial(ctx context.Context, config *rest.Config, clusterName string) (net.Conn, error) {
+	// upgrade 请求只能使用 HTTP/1.1，禁止通过 ALPN 协商为 HTTP/2
+	config = rest.CopyConfig(config)
+	config.NextProtos = []string{"http/1.1"}
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return nil, err
+	}
+	host, err := url.Parse(config.Host)
+	if err != nil {
+		return nil, err
+	}
+	if len(host.Scheme) == 0 {
+		host.Scheme = "https"
+	}
+	location := *host
+	location.Path = path.Join("/", host.Path, "apis", clusterv1alpha1.SchemeGroupVersion.Group, clusterv1alpha1.SchemeGroupVersion.Version,
+		clusterv1alpha1.ResourcePluralCluster, clusterName, "tunnel")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", UpgradeProtocol)
+
+	// 101 响应的 Body 即为双向的连接
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("failed to establish tunnel: %s: %s", resp.Status, string(body))
+	}
+	stream, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to establish tunnel: response body is not writable")
+	}
+	return &streamConn{ReadWriteCloser: stream}, nil
+}
+
+// Serve 由 agent 调用，在隧道连接上作为 HTTP/2 服务端处理控制面转发的请求，阻塞直到连接断开或 ctx 结束
+func Serve(ctx context.Context, conn net.Conn, handler http.Handler) {
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	server := &http2.Server{}
+	server.ServeConn(conn, &http2.ServeConnOpts{Context: ctx, Handler: handler})
+}
+
+// NewProxyHandler 返回 agent 侧的 Handler，把隧道中的请求转发给成员集群的 kube-apiserver。
+// config 应当是只能模拟 kubellm 用户的 impersonator 的配置，控制面在请求中携带 Impersonate-User 与 Impersonate-Group，
+// 由成员集群的 RBAC 按人授权；没有模拟身份的请求直接拒绝。
+func NewProxyHandler(config *rest.Config) (http.Handler, error) {
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return nil, err
+	}
+	host, err := url.Parse(config.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if len(req.Header.Get("Impersonate-User")) == 0 {
+			http.Error(rw, "requests through the tunnel must impersonate a kubellm user", http.StatusForbidden)
+			return
+		}
+		location := *host
+		location.Path = path.Join("/", host.Path, req.URL.Path)
+		if strings.HasSuffix(req.URL.Path, "/") && !strings.HasSuffix(location.Path, "/") {
+			location.Path += "/"
+		}
+
+		handler := proxy.NewUpgradeAwareHandler(&location, transport, false, false, errorResponder{})
+		handler.UseLocationHost = true
+		handler.ServeHTTP(rw, req)
+	}), nil
+}
+
+type errorResponder struct{}
+
+func (errorResponder) Error(rw http.ResponseWriter, req *http.Request, err error) {
+	klog.ErrorS(err, "Failed to proxy request to member cluster", "path", req.URL.Path)
+	http.Error(rw, err.Error(), http.StatusBadGateway)
+}
+
+// streamConn 把 upgrade 后的 io.ReadWriteCloser 包装成 net.Conn，供 HTTP/2 服务端使用
+type streamConn struct {
+	io.ReadWriteCloser
+}
+
+var _ net.Conn = &streamConn{}
+
+func (c *streamConn) LocalAddr() net.Addr                { return tunnelAddr{} }
+func (c *streamConn) RemoteAddr() net.Addr               { return tunnelAddr{} }
+func (c *streamConn) SetDeadline(t time.Time) error      { return nil }
+func (c *streamConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *streamConn) SetWriteDeadline(t time.Time) error { return nil }
+
+type tunnelAddr struct{}
+
+func (tunnelAddr) Network() string { return UpgradeProtocol }
+func (tunnelAddr) String() string  { return UpgradeProtocol }
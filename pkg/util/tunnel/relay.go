@@ -0,0 +1,215 @@
+package tunnel
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/proxy"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/klog/v2"
+)
+
+// relayServerName 是副本之间转发请求时使用的证书名称，也是校验对端证书时使用的 ServerName
+const relayServerName = "kubellm-tunnel-relay"
+
+// RelayCredentials 是副本之间转发请求使用的证书。所有副本共享同一个自签名证书，
+// 它同时作为服务端与客户端证书，副本只信任持有该证书的对端。
+type RelayCredentials struct {
+	cert tls.Certificate
+	pool *x509.CertPool
+}
+
+// LoadRelayCredentials 从核心集群的 Secret 加载转发请求使用的证书，Secret 不存在时生成自签名证书并创建。
+// 多个副本同时创建时只有一个成功，其余副本重新读取已创建的 Secret。
+func LoadRelayCredentials(ctx context.Context, secrets corev1client.SecretsGetter, namespace, name string) (*RelayCredentials, error) {
+	secret, err := secrets.Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		secret, err = newRelaySecret(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		secret, err = secrets.Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			secret, err = secrets.Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tunnel relay secret %s/%s: %w", namespace, name, err)
+	}
+
+	certPEM, keyPEM := secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tunnel relay secret %s/%s: %w", namespace, name, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certPEM) {
+		return nil, fmt.Errorf("invalid tunnel relay secret %s/%s: no certificate found", namespace, name)
+	}
+	return &RelayCredentials{cert: cert, pool: pool}, nil
+}
+
+// newRelaySecret 生成保存自签名证书的 Secret
+func newRelaySecret(namespace, name string) (*corev1.Secret, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := certutil.NewSelfSignedCACert(certutil.Config{CommonName: relayServerName}, key)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: cert.Raw}),
+			corev1.TLSPrivateKeyKey: keyPEM,
+		},
+	}, nil
+}
+
+// serverTLSConfig 返回 relay 服务端的 TLS 配置，只接受持有共享证书的客户端。
+// 隧道需要接管连接，因此只使用 HTTP/1.1。
+func (c *RelayCredentials) serverTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{c.cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    c.pool,
+		NextProtos:   []string{"http/1.1"},
+	}
+}
+
+// clientTLSConfig 返回 relay 客户端的 TLS 配置，只信任持有共享证书的服务端
+func (c *RelayCredentials) clientTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{c.cert},
+		RootCAs:      c.pool,
+		ServerName:   relayServerName,
+		NextProtos:   []string{"http/1.1"},
+	}
+}
+
+// EnableRelay 启用副本之间的转发。不活跃的副本把隧道与经由隧道的请求转发给 SetLeader 设置的活跃副本。
+func (s *Server) EnableRelay(credentials *RelayCredentials) {
+	s.relayTransport.Store(&http.Transport{
+		TLSClientConfig:     credentials.clientTLSConfig(),
+		DialContext:         (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConnsPerHost: 100,
+	})
+}
+
+// SetLeader 设置活跃副本 relay 的地址，为空表示当前没有已知的活跃副本
+func (s *Server) SetLeader(address string) {
+	s.leader.Store(address)
+}
+
+// relayURL 返回活跃副本 relay 上的地址，未启用转发或没有已知的活跃副本时返回 false
+func (s *Server) relayURL(elem ...string) (*url.URL, http.RoundTripper, bool) {
+	transport := s.relayTransport.Load()
+	leader, _ := s.leader.Load().(string)
+	if transport == nil || len(leader) == 0 {
+		return nil, nil, false
+	}
+	return &url.URL{Scheme: "https", Host: leader, Path: path.Join(append([]string{"/"}, elem...)...)}, transport, true
+}
+
+// Route 返回把请求转发给指定集群时的目标地址与 RoundTripper，调用方在地址的 Path 后拼接成员集群中的路径。
+// 活跃副本经由本地的隧道转发；不活跃的副本经由 relay 转发给活跃副本，由它经由隧道转发。
+// 隧道不存在或没有已知的活跃副本时返回 false。
+func (s *Server) Route(clusterName string) (*url.URL, http.RoundTripper, bool) {
+	if !s.Active() {
+		return s.relayURL("clusters", clusterName, "proxy")
+	}
+	transport, ok := s.RoundTripper(clusterName)
+	if !ok {
+		return nil, nil, false
+	}
+	return &url.URL{Scheme: "https", Host: clusterName}, transport, true
+}
+
+// RelayTunnel 返回把 agent 建立隧道的 upgrade 请求转发给活跃副本的 Handler，没有已知的活跃副本时返回 false
+func (s *Server) RelayTunnel(clusterName string, responder proxy.ErrorResponder) (http.Handler, bool) {
+	location, transport, ok := s.relayURL("clusters", clusterName, "tunnel")
+	if !ok {
+		return nil, false
+	}
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		// relay 以共享证书认证，不转发 agent 的凭证
+		req.Header.Del("Authorization")
+		handler := proxy.NewUpgradeAwareHandler(location, transport, false, true, responder)
+		handler.ServeHTTP(rw, req)
+	}), true
+}
+
+// ServeRelay 在 listener 上提供 relay 服务，直到 ctx 结束。请求已由转发它的副本认证与鉴权，
+// 这里只校验对端持有共享证书：
+//   - /clusters/{name}/tunnel 接受其他副本转发的隧道
+//   - /clusters/{name}/proxy/{path} 经由隧道把请求转发给成员集群的 {path}
+func (s *Server) ServeRelay(ctx context.Context, listener net.Listener, credentials *RelayCredentials) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /clusters/{name}/tunnel", func(rw http.ResponseWriter, req *http.Request) {
+		if !s.Active() {
+			http.Error(rw, "this replica does not serve tunnels", http.StatusServiceUnavailable)
+			return
+		}
+		if err := s.Accept(req.Context(), req.PathValue("name"), rw, req); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+		}
+	})
+	proxyHandler := func(rw http.ResponseWriter, req *http.Request) {
+		clusterName := req.PathValue("name")
+		// 只经由本地的隧道转发，避免在副本之间循环
+		transport, ok := s.RoundTripper(clusterName)
+		if !ok {
+			http.Error(rw, fmt.Sprintf("no tunnel is established by the agent of cluster %s", clusterName), http.StatusServiceUnavailable)
+			return
+		}
+		location := &url.URL{Scheme: "https", Host: clusterName, Path: strings.TrimPrefix(req.URL.Path, "/clusters/"+clusterName+"/proxy")}
+		handler := proxy.NewUpgradeAwareHandler(location, transport, false, false, errorResponder{})
+		handler.UseLocationHost = true
+		handler.ServeHTTP(rw, req)
+	}
+	mux.HandleFunc("/clusters/{name}/proxy", proxyHandler)
+	mux.HandleFunc("/clusters/{name}/proxy/", proxyHandler)
+
+	server := &http.Server{
+		Handler:           mux,
+		TLSConfig:         credentials.serverTLSConfig(),
+		ReadHeaderTimeout: 30 * time.Second,
+		// 禁用 HTTP/2，隧道需要接管 HTTP/1.1 连接
+		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){},
+	}
+	stop := context.AfterFunc(ctx, func() { server.Close() })
+	defer stop()
+
+	klog.InfoS("Serving tunnel relay", "address", listener.Addr().String())
+	if err := server.ServeTLS(listener, "", ""); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package tunnel
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLoadRelayCredentials(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+
+	first, err := LoadRelayCredentials(ctx, client.CoreV1(), "kubellm-system", "relay")
+	if err != nil {
+		t.Fatalf("failed to create relay credentials: %v", err)
+	}
+	if _, err := client.CoreV1().Secrets("kubellm-system").Get(ctx, "relay", metav1.GetOptions{}); err != nil {
+		t.Fatalf("expected relay secret to be created: %v", err)
+	}
+	// 其他副本读取同一个 Secret，得到相同的证书
+	second, err := LoadRelayCredentials(ctx, client.CoreV1(), "kubellm-system", "relay")
+	if err != nil {
+		t.Fatalf("failed to load relay credentials: %v", err)
+	}
+	if string(first.cert.Certificate[0]) != string(second.cert.Certificate[0]) {
+		t.Errorf("expected replicas to share the relay certificate")
+	}
+}
+
+func TestRoute(t *testing.T) {
+	credentials, err := LoadRelayCredentials(context.Background(), fake.NewSimpleClientset().CoreV1(), "kubellm-system", "relay")
+	if err != nil {
+		t.Fatalf("failed to create relay credentials: %v", err)
+	}
+
+	s := NewServer()
+	if _, _, ok := s.Route("member"); ok {
+		t.Errorf("expected no route without a tunnel")
+	}
+
+	s.SetActive(false)
+	if _, _, ok := s.Route("member"); ok {
+		t.Errorf("expected no route when relay is not enabled")
+	}
+	s.EnableRelay(credentials)
+	if _, _, ok := s.Route("member"); ok {
+		t.Errorf("expected no route without a known leader")
+	}
+	s.SetLeader("10.0.0.1:8444")
+	location, _, ok := s.Route("member")
+	if !ok {
+		t.Fatalf("expected standby to route through the leader")
+	}
+	if expected := "https://10.0.0.1:8444/clusters/member/proxy"; location.String() != expected {
+		t.Errorf("expected %s, got %s", expected, location)
+	}
+}
+
+func TestServeRelay(t *testing.T) {
+	credentials, err := LoadRelayCredentials(context.Background(), fake.NewSimpleClientset().CoreV1(), "kubellm-system", "relay")
+	if err != nil {
+		t.Fatalf("failed to create relay credentials: %v", err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewServer()
+	go s.ServeRelay(ctx, listener, credentials)
+	address := "https://" + listener.Addr().String()
+
+	// 不持有共享证书的客户端被拒绝
+	untrusted := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	if resp, err := untrusted.Get(address + "/clusters/member/proxy/api"); err == nil {
+		resp.Body.Close()
+		t.Errorf("expected client without the relay certificate to be rejected, got %s", resp.Status)
+	}
+
+	relay := NewServer()
+	relay.EnableRelay(credentials)
+	client := &http.Client{Transport: relay.relayTransport.Load()}
+	tests := []struct {
+		path     string
+		active   bool
+		expected int
+	}{
+		// 没有隧道时不在副本之间继续转发
+		{path: "/clusters/member/proxy/api", active: true, expected: http.StatusServiceUnavailable},
+		// 不活跃的副本不接受隧道
+		{path: "/clusters/member/tunnel", active: false, expected: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		s.SetActive(tt.active)
+		resp, err := client.Get(address + tt.path)
+		if err != nil {
+			t.Fatalf("failed to request %s: %v", tt.path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.expected {
+			t.Errorf("expected %s to return %d, got %d", tt.path, tt.expected, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package tunnel
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/http2"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/klog/v2"
+)
+
+// UpgradeProtocol 是 agent 建立隧道时在 Upgrade 头中声明的协议
+const UpgradeProtocol = "kubellm-tunnel"
+
+// pingInterval 是控制面探测隧道是否存活的间隔
+const pingInterval = 30 * time.Second
+
+// Server 维护 Pull 模式成员集群的 kubellm-agent 主动建立的反向隧道。
+// agent 通过 upgrade 请求接入后，控制面在该连接上作为 HTTP/2 客户端，
+// agent 作为 HTTP/2 服务端把请求转发给成员集群的 kube-apiserver，因此成员集群不需要入站连通性。
+// 隧道只保存在接受它的进程内存中，多个 kubellm-apiserver 副本时只有一个副本处于活跃状态并持有隧道，
+// 其余副本把隧道与经由隧道的请求通过 relay 转发给活跃副本，见 SetActive 与 EnableRelay。
+type Server struct {
+	transport *http2.Transport
+	// active 为 false 时拒绝新的隧道并且不提供已有的隧道
+	active atomic.Bool
+
+	// relayTransport 是转发给活跃副本时使用的 Transport，为空时不转发
+	relayTransport atomic.Pointer[http.Transport]
+	// leader 是活跃副本 relay 的地址
+	leader atomic.Value
+
+	mu      sync.RWMutex
+	tunnels map[string]*http2.ClientConn
+}
+
+// NewServer 创建隧道服务端，创建后处于活跃状态
+func NewServer() *Server {
+	s := &Server{
+		transport: &http2.Transport{},
+		tunnels:   make(map[string]*http2.ClientConn),
+	}
+	s.active.Store(true)
+	return s
+}
+
+// SetActive 设置当前副本是否负责维护隧道。变为不活跃时关闭所有已建立的隧道，agent 会重新连接到活跃的副本。
+func (s *Server) SetActive(active bool) {
+	s.active.Store(active)
+	if active {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for clusterName, cc := range s.tunnels {
+		cc.Close()
+		delete(s.tunnels, clusterName)
+	}
+}
+
+// Active 返回当前副本是否负责维护隧道
+func (s *Server) Active() bool {
+	return s.active.Load()
+}
+
+// RoundTripper 返回经由指定集群隧道发送请求的 RoundTripper，隧道不存在、已关闭或当前副本不活跃时返回 false
+func (s *Server) RoundTripper(clusterName string) (http.RoundTripper, bool) {
+	if !s.Active() {
+		return nil, false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cc, ok := s.tunnels[clusterName]
+	if !ok || !cc.CanTakeNewRequest() {
+		return nil, false
+	}
+	return cc, true
+}
+
+// Accept 接管 agent 的 upgrade 请求并登记为集群的隧道，阻塞直到隧道断开或 ctx 结束。
+// 同一个集群重复接入时，新的隧道会替换旧的隧道。
+func (s *Server) Accept(ctx context.Context, clusterName string, rw http.ResponseWriter, req *http.Request) error {
+	if !httpstream.IsUpgradeRequest(req) || !strings.EqualFold(req.Header.Get("Upgrade"), UpgradeProtocol) {
+		return fmt.Errorf("tunnel requires an upgrade request with protocol %q", UpgradeProtocol)
+	}
+	hijacker, ok := rw.(http.Hijacker)
+	if !ok {
+		return fmt.Errorf("unable to upgrade: response writer does not support hijacking")
+	}
+	conn, bufrw, err := hijacker.Hijack()
+	if err != nil {
+		return fmt.Errorf("unable to upgrade: %w", err)
+	}
+	// 连接被接管后无法再返回 HTTP 错误，以下错误只记录日志
+	logger := klog.FromContext(ctx).WithValues("cluster", clusterName)
+
+	if _, err := fmt.Fprintf(bufrw, "HTTP/1.1 %d %s\r\nConnection: Upgrade\r\nUpgrade: %s\r\n\r\n",
+		http.StatusSwitchingProtocols, http.StatusText(http.StatusSwitchingProtocols), UpgradeProtocol); err != nil {
+		conn.Close()
+		logger.Error(err, "Failed to write tunnel upgrade response")
+		return nil
+	}
+	if err := bufrw.Flush(); err != nil {
+		conn.Close()
+		logger.Error(err, "Failed to write tunnel upgrade response")
+		return nil
+	}
+
+	cc, err := s.transport.NewClientConn(&bufferedConn{Conn: conn, reader: bufrw.Reader})
+	if err != nil {
+		conn.Close()
+		logger.Error(err, "Failed to establish tunnel")
+		return nil
+	}
+
+	s.mu.Lock()
+	// 升级期间当前副本可能已经变为不活跃
+	if !s.Active() {
+		s.mu.Unlock()
+		cc.Close()
+		logger.Info("Tunnel rejected, this replica is not active")
+		return nil
+	}
+	if old, ok := s.tunnels[clusterName]; ok {
+		old.Close()
+	}
+	s.tunnels[clusterName] = cc
+	s.mu.Unlock()
+	logger.Info("Tunnel established")
+
+	defer func() {
+		s.mu.Lock()
+		if s.tunnels[clusterName] == cc {
+			delete(s.tunnels, clusterName)
+		}
+		s.mu.Unlock()
+		cc.Close()
+		logger.Info("Tunnel closed")
+	}()
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if cc.State().Closed {
+				return nil
+			}
+			pingCtx, cancel := context.WithTimeout(ctx, pingInterval)
+			err := cc.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				logger.Error(err, "Tunnel ping failed")
+				return nil
+			}
+		}
+	}
+}
+
+// bufferedConn 优先读取 Hijack 时已经缓冲的数据
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}